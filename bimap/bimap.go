@@ -0,0 +1,172 @@
+package bimap
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rojack96/treje/common"
+	"github.com/rojack96/treje/mapset/types"
+)
+
+// BiMap - One to one map, looked up by key as well as by value
+type BiMap[K comparable, V comparable] struct {
+	forward  map[K]V
+	backward map[V]K
+}
+
+// New - Create a new empty bidirectional map
+func New[K comparable, V comparable]() *BiMap[K, V] {
+	return &BiMap[K, V]{forward: map[K]V{}, backward: map[V]K{}}
+}
+
+/*
+	Manipulation map methods
+*/
+
+// Put - Associate a key and a value, raise an error if either is already bound to something else.
+// Putting an existing pair again does nothing.
+func (m *BiMap[K, V]) Put(key K, value V) error {
+	if current, ok := m.forward[key]; ok {
+		if current == value {
+			return nil
+		}
+		return errors.New(fmt.Sprint(key) + " " + common.AlreadyExists)
+	}
+	if _, ok := m.backward[value]; ok {
+		return errors.New(fmt.Sprint(value) + " " + common.AlreadyExists)
+	}
+
+	m.forward[key] = value
+	m.backward[value] = key
+	return nil
+}
+
+// ForcePut - Associate a key and a value, dropping the pairs previously holding either of them.
+// Return true if a pair was dropped.
+func (m *BiMap[K, V]) ForcePut(key K, value V) bool {
+	if current, ok := m.forward[key]; ok && current == value {
+		return false
+	}
+
+	dropped := m.DeleteByKey(key)
+	if m.DeleteByValue(value) {
+		dropped = true
+	}
+	m.forward[key] = value
+	m.backward[value] = key
+	return dropped
+}
+
+// DeleteByKey - Remove the pair of a key, return true if it was present
+func (m *BiMap[K, V]) DeleteByKey(key K) bool {
+	value, ok := m.forward[key]
+	if ok {
+		delete(m.forward, key)
+		delete(m.backward, value)
+	}
+	return ok
+}
+
+// DeleteByValue - Remove the pair of a value, return true if it was present
+func (m *BiMap[K, V]) DeleteByValue(value V) bool {
+	key, ok := m.backward[value]
+	if ok {
+		delete(m.backward, value)
+		delete(m.forward, key)
+	}
+	return ok
+}
+
+// Clear - Remove all pairs, inverse views are cleared too
+func (m *BiMap[K, V]) Clear() {
+	for key := range m.forward {
+		delete(m.forward, key)
+	}
+	for value := range m.backward {
+		delete(m.backward, value)
+	}
+}
+
+/*
+	Lookup methods
+*/
+
+// GetByKey - Return the value bound to a key and true if the key is present
+func (m *BiMap[K, V]) GetByKey(key K) (V, bool) {
+	value, ok := m.forward[key]
+	return value, ok
+}
+
+// GetByValue - Return the key bound to a value and true if the value is present
+func (m *BiMap[K, V]) GetByValue(value V) (K, bool) {
+	key, ok := m.backward[value]
+	return key, ok
+}
+
+// HasKey - Return true if the key is present, else false
+func (m *BiMap[K, V]) HasKey(key K) bool {
+	_, ok := m.forward[key]
+	return ok
+}
+
+// HasValue - Return true if the value is present, else false
+func (m *BiMap[K, V]) HasValue(value V) bool {
+	_, ok := m.backward[value]
+	return ok
+}
+
+// Len - Return the number of pairs
+func (m *BiMap[K, V]) Len() int {
+	return len(m.forward)
+}
+
+// IsEmpty - Return true if the map is empty, else false
+func (m *BiMap[K, V]) IsEmpty() bool {
+	return len(m.forward) == 0
+}
+
+/*
+	Methods to manipulate a map object
+*/
+
+// Inverse - Return a view of the map from values to keys, changes to either are seen by both
+func (m *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	return &BiMap[V, K]{forward: m.backward, backward: m.forward}
+}
+
+// KeySet - Return the set of keys
+func (m *BiMap[K, V]) KeySet() types.ComparableSet[K] {
+	keys := make(types.ComparableSet[K], len(m.forward))
+	for key := range m.forward {
+		keys.Add(key)
+	}
+	return keys
+}
+
+// ValueSet - Return the set of values
+func (m *BiMap[K, V]) ValueSet() types.ComparableSet[V] {
+	values := make(types.ComparableSet[V], len(m.backward))
+	for value := range m.backward {
+		values.Add(value)
+	}
+	return values
+}
+
+// Each - Call fn on each pair until it returns false, the order is not specified
+func (m *BiMap[K, V]) Each(fn func(key K, value V) bool) {
+	for key, value := range m.forward {
+		if !fn(key, value) {
+			return
+		}
+	}
+}
+
+// Copy - Return an independent copy of the map
+func (m *BiMap[K, V]) Copy() *BiMap[K, V] {
+	c := &BiMap[K, V]{forward: make(map[K]V, len(m.forward)), backward: make(map[V]K, len(m.backward))}
+	for key, value := range m.forward {
+		c.forward[key] = value
+		c.backward[value] = key
+	}
+	return c
+}
@@ -0,0 +1,217 @@
+package bloom
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/bits"
+
+	"github.com/rojack96/treje/common"
+)
+
+// MaxHashes - Largest number of hash functions, above any rate a float64 can express
+const MaxHashes = 2048
+
+// BloomFilter - Probabilistic set answering "maybe present" or "surely absent"
+type BloomFilter[T any] struct {
+	bits   []uint64
+	m      uint64
+	k      uint64
+	hash   common.Hasher[T]
+	filled uint64
+}
+
+// New - Create a filter sized for the expected number of items and target false positive rate
+func New[T common.Ordered](expected uint64, rate float64) (*BloomFilter[T], error) {
+	return NewFunc(expected, rate, common.Hash[T])
+}
+
+// NewFunc - Create a filter sized for the expected items and rate using a custom hash
+func NewFunc[T any](expected uint64, rate float64, hash common.Hasher[T]) (*BloomFilter[T], error) {
+	if rate <= 0 || rate >= 1 {
+		return nil, errors.New(common.InvalidRate)
+	}
+	if expected == 0 {
+		expected = 1
+	}
+
+	m := uint64(math.Ceil(-float64(expected) * math.Log(rate) / (math.Ln2 * math.Ln2)))
+	k := uint64(math.Round(float64(m) / float64(expected) * math.Ln2))
+	return NewSized(m, k, hash), nil
+}
+
+// NewSized - Create a filter of m bits using k hash functions, k is capped at MaxHashes.
+// Filters can be combined only when they share m, k and the hash.
+func NewSized[T any](m, k uint64, hash common.Hasher[T]) *BloomFilter[T] {
+	m = (m + 63) / 64 * 64
+	if m == 0 {
+		m = 64
+	}
+	if k == 0 {
+		k = 1
+	}
+	if k > MaxHashes {
+		k = MaxHashes
+	}
+	return &BloomFilter[T]{bits: make([]uint64, m/64), m: m, k: k, hash: hash}
+}
+
+// FromMapSet - Create a filter seeded with the elements of a map set,
+// sized for the set length and the target false positive rate
+func FromMapSet[T common.Ordered, S ~map[T]V, V any](set S, rate float64) (*BloomFilter[T], error) {
+	f, err := New[T](uint64(len(set)), rate)
+	if err != nil {
+		return nil, err
+	}
+	for elem := range set {
+		f.Add(elem)
+	}
+	return f, nil
+}
+
+/*
+	Manipulation methods
+*/
+
+// Add - Insert an element
+func (f *BloomFilter[T]) Add(elem T) {
+	h1, h2 := f.hashes(elem)
+	for i := uint64(0); i < f.k; i++ {
+		bit := (h1 + i*h2) % f.m
+		if f.bits[bit/64]&(1<<(bit%64)) == 0 {
+			f.bits[bit/64] |= 1 << (bit % 64)
+			f.filled++
+		}
+	}
+}
+
+// MayContain - Return false if the element was surely never added, true if it probably was
+func (f *BloomFilter[T]) MayContain(elem T) bool {
+	h1, h2 := f.hashes(elem)
+	for i := uint64(0); i < f.k; i++ {
+		bit := (h1 + i*h2) % f.m
+		if f.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Clear - Remove all elements
+func (f *BloomFilter[T]) Clear() {
+	for i := range f.bits {
+		f.bits[i] = 0
+	}
+	f.filled = 0
+}
+
+/*
+	Set operation methods
+*/
+
+// Union - Returns a new filter matching the elements of both filters
+func (f *BloomFilter[T]) Union(b *BloomFilter[T]) (*BloomFilter[T], error) {
+	return f.combine(b, func(x, y uint64) uint64 { return x | y })
+}
+
+// Intersect - Returns a new filter matching the elements of both filters.
+// Its false positive rate is at least the one of the filters being intersected.
+func (f *BloomFilter[T]) Intersect(b *BloomFilter[T]) (*BloomFilter[T], error) {
+	return f.combine(b, func(x, y uint64) uint64 { return x & y })
+}
+
+/*
+	Utility methods
+*/
+
+// IsEmpty - Return true if no element was added, else false
+func (f *BloomFilter[T]) IsEmpty() bool {
+	return f.filled == 0
+}
+
+// Bits - Return the number of bits of the filter
+func (f *BloomFilter[T]) Bits() uint64 {
+	return f.m
+}
+
+// HashCount - Return the number of hash functions
+func (f *BloomFilter[T]) HashCount() uint64 {
+	return f.k
+}
+
+// EstimatedCount - Return an estimation of the number of distinct elements added
+func (f *BloomFilter[T]) EstimatedCount() float64 {
+	if f.filled == f.m {
+		return math.Inf(1)
+	}
+	return -float64(f.m) / float64(f.k) * math.Log(1-float64(f.filled)/float64(f.m))
+}
+
+// FalsePositiveRate - Return the current probability that MayContain is wrong
+func (f *BloomFilter[T]) FalsePositiveRate() float64 {
+	return math.Pow(float64(f.filled)/float64(f.m), float64(f.k))
+}
+
+/*
+	Methods to manipulate a filter object
+*/
+
+// Copy - Return a deep copy of the filter
+func (f *BloomFilter[T]) Copy() *BloomFilter[T] {
+	c := *f
+	c.bits = make([]uint64, len(f.bits))
+	copy(c.bits, f.bits)
+	return &c
+}
+
+// MarshalBinary - Encode the size, the hash count and the bits of the filter
+func (f *BloomFilter[T]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 16+8*len(f.bits))
+	binary.BigEndian.PutUint64(data[0:], f.m)
+	binary.BigEndian.PutUint64(data[8:], f.k)
+	for i, word := range f.bits {
+		binary.BigEndian.PutUint64(data[16+8*i:], word)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary - Replace the filter with encoded data, the hash of the receiver is kept
+// and must be the one used by the encoded filter
+func (f *BloomFilter[T]) UnmarshalBinary(data []byte) error {
+	if f.hash == nil || len(data) < 16 || (len(data)-16)%8 != 0 {
+		return errors.New(common.InvalidData)
+	}
+
+	m := binary.BigEndian.Uint64(data[0:])
+	k := binary.BigEndian.Uint64(data[8:])
+	words := data[16:]
+	if m == 0 || k == 0 || k > MaxHashes || m%64 != 0 || uint64(len(words)) != m/8 {
+		return errors.New(common.InvalidData)
+	}
+
+	f.m, f.k, f.filled = m, k, 0
+	f.bits = make([]uint64, m/64)
+	for i := range f.bits {
+		f.bits[i] = binary.BigEndian.Uint64(words[8*i:])
+		f.filled += uint64(bits.OnesCount64(f.bits[i]))
+	}
+	return nil
+}
+
+func (f *BloomFilter[T]) hashes(elem T) (uint64, uint64) {
+	h := common.Mix64(f.hash(elem))
+	return h, common.Mix64(h) | 1
+}
+
+func (f *BloomFilter[T]) combine(b *BloomFilter[T], op func(x, y uint64) uint64) (*BloomFilter[T], error) {
+	if f.m != b.m || f.k != b.k {
+		return nil, errors.New(common.Incompatible)
+	}
+
+	result := NewSized(f.m, f.k, f.hash)
+	for i := range f.bits {
+		result.bits[i] = op(f.bits[i], b.bits[i])
+		result.filled += uint64(bits.OnesCount64(result.bits[i]))
+	}
+	return result, nil
+}
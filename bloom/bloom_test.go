@@ -0,0 +1,35 @@
+package bloom
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/rojack96/treje/common"
+)
+
+func TestNewSizedCapsHashes(t *testing.T) {
+	f := NewSized[int](64, 1<<40, common.Hash[int])
+	if f.HashCount() != MaxHashes {
+		t.Fatalf("hash count = %d, want %d", f.HashCount(), MaxHashes)
+	}
+	f.Add(1)
+	if !f.MayContain(1) {
+		t.Fatal("added element not found")
+	}
+}
+
+func TestUnmarshalBinaryRejectsTooManyHashes(t *testing.T) {
+	f, _ := New[int](100, 0.01)
+	f.Add(1)
+	data, _ := f.MarshalBinary()
+
+	g, _ := New[int](10, 0.1)
+	if err := g.UnmarshalBinary(data); err != nil || !g.MayContain(1) {
+		t.Fatalf("round trip failed: %v", err)
+	}
+
+	binary.BigEndian.PutUint64(data[8:], MaxHashes+1)
+	if err := g.UnmarshalBinary(data); err == nil {
+		t.Fatal("expected an error")
+	}
+}
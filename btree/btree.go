@@ -0,0 +1,347 @@
+package btree
+
+import (
+	"errors"
+
+	"github.com/rojack96/treje/common"
+)
+
+// DefaultDegree - Minimum degree used when an invalid one is requested
+const DefaultDegree = 32
+
+// Entry - Key and value pair stored in the tree
+type Entry[K, V any] struct {
+	Key   K
+	Value V
+}
+
+// BTree - In-memory ordered map backed by a B-tree.
+// Every node but the root holds between degree-1 and 2*degree-1 entries.
+type BTree[K, V any] struct {
+	root    *node[K, V]
+	size    int
+	degree  int
+	compare common.Comparator[K]
+	owner   *owner
+}
+
+// New - Create a new empty B-tree of the given minimum degree for ordered keys
+func New[K common.Ordered, V any](degree int) *BTree[K, V] {
+	return NewFunc[K, V](degree, common.Compare[K])
+}
+
+// NewFunc - Create a new empty B-tree of the given minimum degree ordered by the comparator
+func NewFunc[K, V any](degree int, compare common.Comparator[K]) *BTree[K, V] {
+	if degree < 2 {
+		degree = DefaultDegree
+	}
+	return &BTree[K, V]{degree: degree, compare: compare, owner: &owner{}}
+}
+
+func (t *BTree[K, V]) maxEntries() int {
+	return 2*t.degree - 1
+}
+
+/*
+	Manipulation map methods
+*/
+
+// Put - Insert or replace the value of a key, return true if the key is new
+func (t *BTree[K, V]) Put(key K, value V) bool {
+	if t.root == nil {
+		t.root = &node[K, V]{owner: t.owner}
+		t.root.entries = append(make([]Entry[K, V], 0, t.maxEntries()), Entry[K, V]{key, value})
+		t.size++
+		return true
+	}
+
+	t.root = t.mutable(t.root)
+	if len(t.root.entries) >= t.maxEntries() {
+		root := &node[K, V]{owner: t.owner}
+		root.children = append(make([]*node[K, V], 0, t.maxEntries()+1), t.root)
+		t.split(root, 0)
+		t.root = root
+	}
+
+	if t.insert(t.root, key, value) {
+		t.size++
+		return true
+	}
+	return false
+}
+
+func (t *BTree[K, V]) insert(n *node[K, V], key K, value V) bool {
+	i, found := t.search(n, key)
+	if found {
+		n.entries[i].Value = value
+		return false
+	}
+
+	if n.leaf() {
+		n.entries = insertAt(n.entries, i, Entry[K, V]{key, value})
+		return true
+	}
+
+	if len(t.mutableChild(n, i).entries) >= t.maxEntries() {
+		t.split(n, i)
+		switch c := t.compare(key, n.entries[i].Key); {
+		case c == 0:
+			n.entries[i].Value = value
+			return false
+		case c > 0:
+			i++
+		}
+	}
+	return t.insert(n.children[i], key, value)
+}
+
+// Delete - Remove a key, return true if the key was present
+func (t *BTree[K, V]) Delete(key K) bool {
+	if t.root == nil {
+		return false
+	}
+
+	t.root = t.mutable(t.root)
+	deleted := t.delete(t.root, key)
+
+	if len(t.root.entries) == 0 {
+		if t.root.leaf() {
+			t.root = nil
+		} else {
+			t.root = t.root.children[0]
+		}
+	}
+
+	if deleted {
+		t.size--
+	}
+	return deleted
+}
+
+func (t *BTree[K, V]) delete(n *node[K, V], key K) bool {
+	i, found := t.search(n, key)
+
+	if n.leaf() {
+		if found {
+			n.entries = removeAt(n.entries, i)
+		}
+		return found
+	}
+
+	if found {
+		switch {
+		case len(n.children[i].entries) >= t.degree:
+			left := t.mutableChild(n, i)
+			predecessor := maxEntry(left)
+			n.entries[i] = predecessor
+			return t.delete(left, predecessor.Key)
+		case len(n.children[i+1].entries) >= t.degree:
+			right := t.mutableChild(n, i+1)
+			successor := minEntry(right)
+			n.entries[i] = successor
+			return t.delete(right, successor.Key)
+		default:
+			t.merge(n, i)
+			return t.delete(n.children[i], key)
+		}
+	}
+
+	if len(n.children[i].entries) < t.degree {
+		i = t.fill(n, i)
+	}
+	return t.delete(t.mutableChild(n, i), key)
+}
+
+// Remove - Remove a key, if the key not exists raise an error
+func (t *BTree[K, V]) Remove(key K) error {
+	if t.IsEmpty() {
+		return errors.New(common.EmptySet)
+	}
+	if !t.Delete(key) {
+		return errors.New(common.KeyNotExist)
+	}
+	return nil
+}
+
+// Clear - Remove all entries
+func (t *BTree[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// Load - Replace the content of the tree with entries sorted by strictly ascending key.
+// The tree is built bottom-up in linear time.
+func (t *BTree[K, V]) Load(entries []Entry[K, V]) error {
+	for i := 1; i < len(entries); i++ {
+		if t.compare(entries[i-1].Key, entries[i].Key) >= 0 {
+			return errors.New(common.NotOrdered)
+		}
+	}
+
+	t.owner = &owner{}
+	t.size = len(entries)
+	if len(entries) == 0 {
+		t.root = nil
+		return nil
+	}
+
+	// capacity[h] is the number of entries held by a full subtree of height h+1
+	capacity := []int{t.maxEntries()}
+	for capacity[len(capacity)-1] < len(entries) {
+		last := capacity[len(capacity)-1]
+		capacity = append(capacity, last+(last+1)*t.maxEntries())
+	}
+
+	t.root = t.build(entries, capacity, len(capacity)-1)
+	return nil
+}
+
+// build - Create a subtree of height h+1 holding the given entries
+func (t *BTree[K, V]) build(entries []Entry[K, V], capacity []int, h int) *node[K, V] {
+	n := &node[K, V]{owner: t.owner}
+	if h == 0 {
+		n.entries = append(make([]Entry[K, V], 0, t.maxEntries()), entries...)
+		return n
+	}
+
+	children := (len(entries) + capacity[h-1] + 1) / (capacity[h-1] + 1)
+	if children < 2 {
+		children = 2
+	}
+	base := (len(entries) - children + 1) / children
+	extra := (len(entries) - children + 1) % children
+
+	n.entries = make([]Entry[K, V], 0, t.maxEntries())
+	n.children = make([]*node[K, V], 0, t.maxEntries()+1)
+	for j := 0; j < children; j++ {
+		size := base
+		if j < extra {
+			size++
+		}
+		n.children = append(n.children, t.build(entries[:size], capacity, h-1))
+		entries = entries[size:]
+		if j < children-1 {
+			n.entries = append(n.entries, entries[0])
+			entries = entries[1:]
+		}
+	}
+	return n
+}
+
+// Clone - Return a snapshot of the tree in constant time.
+// Nodes are shared and copied lazily by whichever tree modifies them first.
+func (t *BTree[K, V]) Clone() *BTree[K, V] {
+	c := *t
+	t.owner = &owner{}
+	c.owner = &owner{}
+	return &c
+}
+
+/*
+	Lookup methods
+*/
+
+// Get - Return the value of a key and true if the key is present
+func (t *BTree[K, V]) Get(key K) (V, bool) {
+	n := t.root
+	for n != nil {
+		i, found := t.search(n, key)
+		if found {
+			return n.entries[i].Value, true
+		}
+		if n.leaf() {
+			break
+		}
+		n = n.children[i]
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Has - Return true if the key is in the tree, otherwise false
+func (t *BTree[K, V]) Has(key K) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Len - Return the number of entries
+func (t *BTree[K, V]) Len() int {
+	return t.size
+}
+
+// IsEmpty - Return true if the tree is empty, else false
+func (t *BTree[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Degree - Return the minimum degree of the tree
+func (t *BTree[K, V]) Degree() int {
+	return t.degree
+}
+
+// Height - Return the number of levels, zero when empty
+func (t *BTree[K, V]) Height() int {
+	h := 0
+	for n := t.root; n != nil; h++ {
+		if n.leaf() {
+			return h + 1
+		}
+		n = n.children[0]
+	}
+	return h
+}
+
+// Min - Return the entry with the smallest key
+func (t *BTree[K, V]) Min() (K, V, bool) {
+	if t.root == nil {
+		var e Entry[K, V]
+		return e.Key, e.Value, false
+	}
+	e := minEntry(t.root)
+	return e.Key, e.Value, true
+}
+
+// Max - Return the entry with the greatest key
+func (t *BTree[K, V]) Max() (K, V, bool) {
+	if t.root == nil {
+		var e Entry[K, V]
+		return e.Key, e.Value, false
+	}
+	e := maxEntry(t.root)
+	return e.Key, e.Value, true
+}
+
+// Keys - Return all keys in ascending order
+func (t *BTree[K, V]) Keys() []K {
+	keys := make([]K, 0, t.size)
+	t.InOrder(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Values - Return all values ordered by key
+func (t *BTree[K, V]) Values() []V {
+	values := make([]V, 0, t.size)
+	t.InOrder(func(_ K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+func minEntry[K, V any](n *node[K, V]) Entry[K, V] {
+	for !n.leaf() {
+		n = n.children[0]
+	}
+	return n.entries[0]
+}
+
+func maxEntry[K, V any](n *node[K, V]) Entry[K, V] {
+	for !n.leaf() {
+		n = n.children[len(n.children)-1]
+	}
+	return n.entries[len(n.entries)-1]
+}
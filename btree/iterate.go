@@ -0,0 +1,85 @@
+package btree
+
+/*
+	Iteration methods
+
+	Every scan calls fn once per entry and stops as soon as fn returns false.
+*/
+
+// InOrder - Visit entries in ascending key order
+func (t *BTree[K, V]) InOrder(fn func(key K, value V) bool) {
+	t.ascend(t.root, nil, nil, fn)
+}
+
+// ReverseOrder - Visit entries in descending key order
+func (t *BTree[K, V]) ReverseOrder(fn func(key K, value V) bool) {
+	t.descend(t.root, nil, nil, fn)
+}
+
+// Range - Visit entries with lo <= key < hi in ascending order
+func (t *BTree[K, V]) Range(lo, hi K, fn func(key K, value V) bool) {
+	t.ascend(t.root, &lo, &hi, fn)
+}
+
+// ReverseRange - Visit entries with lo <= key < hi in descending order
+func (t *BTree[K, V]) ReverseRange(lo, hi K, fn func(key K, value V) bool) {
+	t.descend(t.root, &lo, &hi, fn)
+}
+
+func (t *BTree[K, V]) ascend(n *node[K, V], lo, hi *K, fn func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	start := 0
+	if lo != nil {
+		start, _ = t.search(n, *lo)
+	}
+
+	for i := start; i < len(n.entries); i++ {
+		if !n.leaf() && !t.ascend(n.children[i], lo, hi, fn) {
+			return false
+		}
+		e := n.entries[i]
+		if hi != nil && t.compare(e.Key, *hi) >= 0 {
+			return false
+		}
+		if !fn(e.Key, e.Value) {
+			return false
+		}
+	}
+
+	if !n.leaf() {
+		return t.ascend(n.children[len(n.entries)], lo, hi, fn)
+	}
+	return true
+}
+
+func (t *BTree[K, V]) descend(n *node[K, V], lo, hi *K, fn func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	end := len(n.entries)
+	if hi != nil {
+		end, _ = t.search(n, *hi)
+	}
+
+	if !n.leaf() && !t.descend(n.children[end], lo, hi, fn) {
+		return false
+	}
+
+	for i := end - 1; i >= 0; i-- {
+		e := n.entries[i]
+		if lo != nil && t.compare(e.Key, *lo) < 0 {
+			return false
+		}
+		if !fn(e.Key, e.Value) {
+			return false
+		}
+		if !n.leaf() && !t.descend(n.children[i], lo, hi, fn) {
+			return false
+		}
+	}
+	return true
+}
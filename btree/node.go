@@ -0,0 +1,139 @@
+package btree
+
+import "sort"
+
+// owner - Identity of the tree allowed to mutate a node in place.
+// It must not be zero-sized, distinct owners need distinct addresses.
+type owner struct {
+	_ byte
+}
+
+type node[K, V any] struct {
+	entries  []Entry[K, V]
+	children []*node[K, V]
+	owner    *owner
+}
+
+func (n *node[K, V]) leaf() bool {
+	return len(n.children) == 0
+}
+
+// search - Return the index of the first entry with a key greater than or equal to key
+func (t *BTree[K, V]) search(n *node[K, V], key K) (int, bool) {
+	i := sort.Search(len(n.entries), func(i int) bool {
+		return t.compare(n.entries[i].Key, key) >= 0
+	})
+	return i, i < len(n.entries) && t.compare(n.entries[i].Key, key) == 0
+}
+
+// mutable - Return a node this tree can modify, copying it when shared with a clone
+func (t *BTree[K, V]) mutable(n *node[K, V]) *node[K, V] {
+	if n.owner == t.owner {
+		return n
+	}
+
+	c := &node[K, V]{owner: t.owner}
+	c.entries = make([]Entry[K, V], len(n.entries), cap(n.entries))
+	copy(c.entries, n.entries)
+	if !n.leaf() {
+		c.children = make([]*node[K, V], len(n.children), cap(n.children))
+		copy(c.children, n.children)
+	}
+	return c
+}
+
+func (t *BTree[K, V]) mutableChild(n *node[K, V], i int) *node[K, V] {
+	c := t.mutable(n.children[i])
+	n.children[i] = c
+	return c
+}
+
+func insertAt[T any](s []T, i int, v T) []T {
+	var zero T
+	s = append(s, zero)
+	copy(s[i+1:], s[i:])
+	s[i] = v
+	return s
+}
+
+func removeAt[T any](s []T, i int) []T {
+	var zero T
+	copy(s[i:], s[i+1:])
+	s[len(s)-1] = zero
+	return s[:len(s)-1]
+}
+
+/*
+	Structural changes, n and the children involved must already be mutable
+*/
+
+// split - Split the full child i of n, moving its median entry up into n
+func (t *BTree[K, V]) split(n *node[K, V], i int) {
+	child := n.children[i]
+	mid := t.degree - 1
+
+	right := &node[K, V]{owner: t.owner}
+	right.entries = append(make([]Entry[K, V], 0, t.maxEntries()), child.entries[mid+1:]...)
+	if !child.leaf() {
+		right.children = append(make([]*node[K, V], 0, t.maxEntries()+1), child.children[mid+1:]...)
+		for j := mid + 1; j < len(child.children); j++ {
+			child.children[j] = nil
+		}
+		child.children = child.children[:mid+1]
+	}
+
+	median := child.entries[mid]
+	for j := mid; j < len(child.entries); j++ {
+		child.entries[j] = Entry[K, V]{}
+	}
+	child.entries = child.entries[:mid]
+
+	n.entries = insertAt(n.entries, i, median)
+	n.children = insertAt(n.children, i+1, right)
+}
+
+// merge - Merge child i+1 and the separating entry into child i
+func (t *BTree[K, V]) merge(n *node[K, V], i int) {
+	left := t.mutableChild(n, i)
+	right := n.children[i+1]
+
+	left.entries = append(left.entries, n.entries[i])
+	left.entries = append(left.entries, right.entries...)
+	left.children = append(left.children, right.children...)
+
+	n.entries = removeAt(n.entries, i)
+	n.children = removeAt(n.children, i+1)
+}
+
+// fill - Make sure child i holds at least degree entries before descending into it,
+// return the index of the child that now covers the same key range
+func (t *BTree[K, V]) fill(n *node[K, V], i int) int {
+	switch {
+	case i > 0 && len(n.children[i-1].entries) >= t.degree:
+		child, left := t.mutableChild(n, i), t.mutableChild(n, i-1)
+		child.entries = insertAt(child.entries, 0, n.entries[i-1])
+		n.entries[i-1] = left.entries[len(left.entries)-1]
+		left.entries = removeAt(left.entries, len(left.entries)-1)
+		if !left.leaf() {
+			child.children = insertAt(child.children, 0, left.children[len(left.children)-1])
+			left.children = removeAt(left.children, len(left.children)-1)
+		}
+		return i
+	case i < len(n.entries) && len(n.children[i+1].entries) >= t.degree:
+		child, right := t.mutableChild(n, i), t.mutableChild(n, i+1)
+		child.entries = append(child.entries, n.entries[i])
+		n.entries[i] = right.entries[0]
+		right.entries = removeAt(right.entries, 0)
+		if !right.leaf() {
+			child.children = append(child.children, right.children[0])
+			right.children = removeAt(right.children, 0)
+		}
+		return i
+	case i < len(n.entries):
+		t.merge(n, i)
+		return i
+	default:
+		t.merge(n, i-1)
+		return i - 1
+	}
+}
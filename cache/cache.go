@@ -0,0 +1,180 @@
+package cache
+
+import (
+	"errors"
+	"time"
+
+	"github.com/rojack96/treje/common"
+)
+
+// Clock - Source of the current time, injectable to test expiration
+type Clock func() time.Time
+
+// Reason - Why an entry left the cache on its own
+type Reason int
+
+const (
+	// Evicted - Removed to make room for a new entry
+	Evicted Reason = iota
+	// Expired - Removed because its time to live elapsed
+	Expired
+)
+
+// Policy - Strategy used to choose the entry to evict
+type Policy int
+
+const (
+	// LRUPolicy - Evict the least recently used entry
+	LRUPolicy Policy = iota
+	// LFUPolicy - Evict the least frequently used entry
+	LFUPolicy
+)
+
+// Options - Configuration shared by every cache
+type Options struct {
+	// Capacity - Maximum number of entries, must be positive
+	Capacity int
+	// TTL - Default time to live of the entries, zero means no expiration
+	TTL time.Duration
+	// Clock - Time source, nil uses time.Now
+	Clock Clock
+}
+
+// Stats - Counters of the cache activity
+type Stats struct {
+	Hits        uint64
+	Misses      uint64
+	Evictions   uint64
+	Expirations uint64
+}
+
+// HitRatio - Return the fraction of lookups that found a live entry
+func (s Stats) HitRatio() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// Cache - Capacity-bounded key value cache.
+// Caches are not safe for concurrent use.
+type Cache[K comparable, V any] interface {
+	// Get - Return the value of a live key and record the access
+	Get(key K) (V, bool)
+	// Peek - Return the value of a live key without recording the access
+	Peek(key K) (V, bool)
+	// Put - Insert or replace a value with the default time to live
+	Put(key K, value V)
+	// PutWithTTL - Insert or replace a value expiring after ttl, zero means never
+	PutWithTTL(key K, value V, ttl time.Duration)
+	// Delete - Remove a key, return true if it was present
+	Delete(key K) bool
+	// Has - Return true if the key is present and not expired, without recording the access
+	Has(key K) bool
+	// Len - Return the number of stored entries, expired ones included until purged
+	Len() int
+	// Capacity - Return the maximum number of entries
+	Capacity() int
+	// Keys - Return the live keys, the next to be evicted last
+	Keys() []K
+	// Purge - Remove the expired entries and return how many were removed
+	Purge() int
+	// Clear - Remove all entries, the statistics are kept
+	Clear()
+	// Stats - Return the activity counters
+	Stats() Stats
+	// ResetStats - Set every activity counter back to zero
+	ResetStats()
+	// OnEvict - Register a callback called when an entry is evicted or expires
+	OnEvict(fn func(key K, value V, reason Reason))
+}
+
+// New - Create a new cache with the given eviction policy
+func New[K comparable, V any](policy Policy, options Options) (Cache[K, V], error) {
+	if policy == LFUPolicy {
+		return NewLFU[K, V](options)
+	}
+	return NewLRU[K, V](options)
+}
+
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+	frequency int
+}
+
+// base - State shared by the cache implementations
+type base[K comparable, V any] struct {
+	options Options
+	stats   Stats
+	onEvict func(key K, value V, reason Reason)
+	// earliest is at most the first deadline of the stored entries, zero when none expires
+	earliest time.Time
+}
+
+func (b *base[K, V]) init(options Options) error {
+	if options.Capacity < 1 {
+		return errors.New(common.InvalidCapacity)
+	}
+	if options.Clock == nil {
+		options.Clock = time.Now
+	}
+	b.options = options
+	return nil
+}
+
+func (b *base[K, V]) deadline(ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return b.options.Clock().Add(ttl)
+}
+
+// track - Lower the earliest deadline to the one of an entry being stored
+func (b *base[K, V]) track(deadline time.Time) {
+	if !deadline.IsZero() && (b.earliest.IsZero() || deadline.Before(b.earliest)) {
+		b.earliest = deadline
+	}
+}
+
+// mayHaveExpired - Return false when no stored entry can be expired yet
+func (b *base[K, V]) mayHaveExpired() bool {
+	return !b.earliest.IsZero() && !b.options.Clock().Before(b.earliest)
+}
+
+func (b *base[K, V]) expired(e *entry[K, V]) bool {
+	return !e.expiresAt.IsZero() && !b.options.Clock().Before(e.expiresAt)
+}
+
+// removed - Update the statistics and notify the callback of an entry leaving on its own
+func (b *base[K, V]) removed(e *entry[K, V], reason Reason) {
+	if reason == Expired {
+		b.stats.Expirations++
+	} else {
+		b.stats.Evictions++
+	}
+	if b.onEvict != nil {
+		b.onEvict(e.key, e.value, reason)
+	}
+}
+
+// Capacity - Return the maximum number of entries
+func (b *base[K, V]) Capacity() int {
+	return b.options.Capacity
+}
+
+// Stats - Return the activity counters
+func (b *base[K, V]) Stats() Stats {
+	return b.stats
+}
+
+// ResetStats - Set every activity counter back to zero
+func (b *base[K, V]) ResetStats() {
+	b.stats = Stats{}
+}
+
+// OnEvict - Register a callback called when an entry is evicted or expires
+func (b *base[K, V]) OnEvict(fn func(key K, value V, reason Reason)) {
+	b.onEvict = fn
+}
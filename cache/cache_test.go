@@ -0,0 +1,40 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFullCacheDropsExpiredBeforeEvicting(t *testing.T) {
+	for name, policy := range map[string]Policy{"LRU": LRUPolicy, "LFU": LFUPolicy} {
+		t.Run(name, func(t *testing.T) {
+			now := time.Unix(0, 0)
+			c, err := New[int, string](policy, Options{Capacity: 2, Clock: func() time.Time { return now }})
+			if err != nil {
+				t.Fatal(err)
+			}
+			removed := map[int]Reason{}
+			c.OnEvict(func(key int, _ string, reason Reason) { removed[key] = reason })
+
+			c.Put(1, "a")
+			c.PutWithTTL(2, "b", time.Second)
+			// Key 2 is both the most recent and the most frequent, key 1 is the eviction victim
+			for i := 0; i < 3; i++ {
+				c.Get(2)
+			}
+
+			now = now.Add(2 * time.Second)
+			c.Put(3, "c")
+
+			if !c.Has(1) || !c.Has(3) {
+				t.Fatalf("live keys = %v, want 1 and 3", c.Keys())
+			}
+			if len(removed) != 1 || removed[2] != Expired {
+				t.Fatalf("removed = %v, want only 2 as expired", removed)
+			}
+			if s := c.Stats(); s.Evictions != 0 || s.Expirations != 1 {
+				t.Fatalf("stats = %+v", s)
+			}
+		})
+	}
+}
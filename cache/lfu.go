@@ -0,0 +1,210 @@
+package cache
+
+import (
+	"container/list"
+	"sort"
+	"time"
+)
+
+// LFU - Cache evicting the least frequently used entry, the least recently used among ties
+type LFU[K comparable, V any] struct {
+	base[K, V]
+	items map[K]*list.Element
+	// frequencies holds for each access count its entries from the most to the least recently used
+	frequencies map[int]*list.List
+	minimum     int
+}
+
+// NewLFU - Create a new least frequently used cache
+func NewLFU[K comparable, V any](options Options) (*LFU[K, V], error) {
+	c := &LFU[K, V]{items: map[K]*list.Element{}, frequencies: map[int]*list.List{}}
+	if err := c.init(options); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Get - Return the value of a live key and increase its frequency
+func (c *LFU[K, V]) Get(key K) (V, bool) {
+	el := c.live(key)
+	if el == nil {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+
+	c.stats.Hits++
+	return c.touch(el).value, true
+}
+
+// Peek - Return the value of a live key without changing its frequency
+func (c *LFU[K, V]) Peek(key K) (V, bool) {
+	if el := c.live(key); el != nil {
+		return el.Value.(*entry[K, V]).value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Put - Insert or replace a value with the default time to live
+func (c *LFU[K, V]) Put(key K, value V) {
+	c.PutWithTTL(key, value, c.options.TTL)
+}
+
+// PutWithTTL - Insert or replace a value expiring after ttl, zero means never.
+// Replacing a value counts as an access.
+func (c *LFU[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	if el, ok := c.items[key]; ok {
+		e := c.touch(el)
+		e.value = value
+		e.expiresAt = c.deadline(ttl)
+		c.track(e.expiresAt)
+		return
+	}
+
+	// Expired entries make room before a live one is evicted
+	if len(c.items) >= c.options.Capacity && c.mayHaveExpired() {
+		c.Purge()
+	}
+	if len(c.items) >= c.options.Capacity {
+		c.remove(c.bucket(c.lowest()).Back(), Evicted)
+	}
+	c.minimum = 1
+	e := &entry[K, V]{key: key, value: value, expiresAt: c.deadline(ttl), frequency: 1}
+	c.items[key] = c.bucket(1).PushFront(e)
+	c.track(e.expiresAt)
+}
+
+// Delete - Remove a key, return true if it was present
+func (c *LFU[K, V]) Delete(key K) bool {
+	el, ok := c.items[key]
+	if ok {
+		c.unlink(el)
+	}
+	return ok
+}
+
+// Has - Return true if the key is present and not expired
+func (c *LFU[K, V]) Has(key K) bool {
+	return c.live(key) != nil
+}
+
+// Len - Return the number of stored entries
+func (c *LFU[K, V]) Len() int {
+	return len(c.items)
+}
+
+// Frequency - Return the number of recorded accesses of a key, zero if absent
+func (c *LFU[K, V]) Frequency(key K) int {
+	if el, ok := c.items[key]; ok {
+		return el.Value.(*entry[K, V]).frequency
+	}
+	return 0
+}
+
+// Keys - Return the live keys from the most to the least frequently used
+func (c *LFU[K, V]) Keys() []K {
+	frequencies := make([]int, 0, len(c.frequencies))
+	for f := range c.frequencies {
+		frequencies = append(frequencies, f)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(frequencies)))
+
+	keys := make([]K, 0, len(c.items))
+	for _, f := range frequencies {
+		for el := c.frequencies[f].Front(); el != nil; el = el.Next() {
+			if e := el.Value.(*entry[K, V]); !c.expired(e) {
+				keys = append(keys, e.key)
+			}
+		}
+	}
+	return keys
+}
+
+// Purge - Remove the expired entries and return how many were removed
+func (c *LFU[K, V]) Purge() int {
+	count := 0
+	c.earliest = time.Time{}
+	for _, el := range c.items {
+		if e := el.Value.(*entry[K, V]); c.expired(e) {
+			c.remove(el, Expired)
+			count++
+		} else {
+			c.track(e.expiresAt)
+		}
+	}
+	return count
+}
+
+// Clear - Remove all entries
+func (c *LFU[K, V]) Clear() {
+	c.items = map[K]*list.Element{}
+	c.frequencies = map[int]*list.List{}
+	c.minimum = 0
+	c.earliest = time.Time{}
+}
+
+// live - Return the element of a key, dropping it when expired
+func (c *LFU[K, V]) live(key K) *list.Element {
+	el, ok := c.items[key]
+	if !ok {
+		return nil
+	}
+	if c.expired(el.Value.(*entry[K, V])) {
+		c.remove(el, Expired)
+		return nil
+	}
+	return el
+}
+
+// touch - Move an entry to the next frequency bucket
+func (c *LFU[K, V]) touch(el *list.Element) *entry[K, V] {
+	e := el.Value.(*entry[K, V])
+	c.unlink(el)
+	e.frequency++
+	c.items[e.key] = c.bucket(e.frequency).PushFront(e)
+	if _, ok := c.frequencies[c.minimum]; !ok && c.minimum == e.frequency-1 {
+		c.minimum = e.frequency
+	}
+	return e
+}
+
+// unlink - Detach an element from its bucket and the index
+func (c *LFU[K, V]) unlink(el *list.Element) {
+	e := el.Value.(*entry[K, V])
+	l := c.frequencies[e.frequency]
+	l.Remove(el)
+	if l.Len() == 0 {
+		delete(c.frequencies, e.frequency)
+	}
+	delete(c.items, e.key)
+}
+
+func (c *LFU[K, V]) remove(el *list.Element, reason Reason) {
+	c.unlink(el)
+	c.removed(el.Value.(*entry[K, V]), reason)
+}
+
+func (c *LFU[K, V]) bucket(frequency int) *list.List {
+	l, ok := c.frequencies[frequency]
+	if !ok {
+		l = list.New()
+		c.frequencies[frequency] = l
+	}
+	return l
+}
+
+// lowest - Return the smallest frequency in use, scanning only when the cached minimum is stale
+// after a delete or an expiration
+func (c *LFU[K, V]) lowest() int {
+	if _, ok := c.frequencies[c.minimum]; ok {
+		return c.minimum
+	}
+	c.minimum = 0
+	for f := range c.frequencies {
+		if c.minimum == 0 || f < c.minimum {
+			c.minimum = f
+		}
+	}
+	return c.minimum
+}
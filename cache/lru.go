@@ -0,0 +1,149 @@
+package cache
+
+import (
+	"container/list"
+	"time"
+)
+
+// LRU - Cache evicting the least recently used entry
+type LRU[K comparable, V any] struct {
+	base[K, V]
+	items map[K]*list.Element
+	// order holds the entries from the most to the least recently used
+	order *list.List
+}
+
+// NewLRU - Create a new least recently used cache
+func NewLRU[K comparable, V any](options Options) (*LRU[K, V], error) {
+	c := &LRU[K, V]{items: map[K]*list.Element{}, order: list.New()}
+	if err := c.init(options); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Get - Return the value of a live key and mark it as the most recently used
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	el := c.live(key)
+	if el == nil {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+
+	c.stats.Hits++
+	c.order.MoveToFront(el)
+	return el.Value.(*entry[K, V]).value, true
+}
+
+// Peek - Return the value of a live key without changing its recency
+func (c *LRU[K, V]) Peek(key K) (V, bool) {
+	if el := c.live(key); el != nil {
+		return el.Value.(*entry[K, V]).value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Put - Insert or replace a value with the default time to live
+func (c *LRU[K, V]) Put(key K, value V) {
+	c.PutWithTTL(key, value, c.options.TTL)
+}
+
+// PutWithTTL - Insert or replace a value expiring after ttl, zero means never
+func (c *LRU[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	if el, ok := c.items[key]; ok {
+		e := el.Value.(*entry[K, V])
+		e.value = value
+		e.expiresAt = c.deadline(ttl)
+		c.track(e.expiresAt)
+		c.order.MoveToFront(el)
+		return
+	}
+
+	// Expired entries make room before a live one is evicted
+	if len(c.items) >= c.options.Capacity && c.mayHaveExpired() {
+		c.Purge()
+	}
+	if len(c.items) >= c.options.Capacity {
+		c.remove(c.order.Back(), Evicted)
+	}
+	e := &entry[K, V]{key: key, value: value, expiresAt: c.deadline(ttl)}
+	c.items[key] = c.order.PushFront(e)
+	c.track(e.expiresAt)
+}
+
+// Delete - Remove a key, return true if it was present
+func (c *LRU[K, V]) Delete(key K) bool {
+	el, ok := c.items[key]
+	if ok {
+		c.order.Remove(el)
+		delete(c.items, key)
+	}
+	return ok
+}
+
+// Has - Return true if the key is present and not expired
+func (c *LRU[K, V]) Has(key K) bool {
+	return c.live(key) != nil
+}
+
+// Len - Return the number of stored entries
+func (c *LRU[K, V]) Len() int {
+	return len(c.items)
+}
+
+// Keys - Return the live keys from the most to the least recently used
+func (c *LRU[K, V]) Keys() []K {
+	keys := make([]K, 0, len(c.items))
+	for el := c.order.Front(); el != nil; el = el.Next() {
+		if e := el.Value.(*entry[K, V]); !c.expired(e) {
+			keys = append(keys, e.key)
+		}
+	}
+	return keys
+}
+
+// Purge - Remove the expired entries and return how many were removed
+func (c *LRU[K, V]) Purge() int {
+	count := 0
+	c.earliest = time.Time{}
+	for el := c.order.Front(); el != nil; {
+		next := el.Next()
+		if e := el.Value.(*entry[K, V]); c.expired(e) {
+			c.remove(el, Expired)
+			count++
+		} else {
+			c.track(e.expiresAt)
+		}
+		el = next
+	}
+	return count
+}
+
+// Clear - Remove all entries
+func (c *LRU[K, V]) Clear() {
+	c.items = map[K]*list.Element{}
+	c.order.Init()
+	c.earliest = time.Time{}
+}
+
+// live - Return the element of a key, dropping it when expired
+func (c *LRU[K, V]) live(key K) *list.Element {
+	el, ok := c.items[key]
+	if !ok {
+		return nil
+	}
+	if c.expired(el.Value.(*entry[K, V])) {
+		c.remove(el, Expired)
+		return nil
+	}
+	return el
+}
+
+func (c *LRU[K, V]) remove(el *list.Element, reason Reason) {
+	e := el.Value.(*entry[K, V])
+	c.order.Remove(el)
+	delete(c.items, e.key)
+	c.removed(e, reason)
+}
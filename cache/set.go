@@ -0,0 +1,117 @@
+package cache
+
+import (
+	"errors"
+
+	"github.com/rojack96/treje/common"
+	mtype "github.com/rojack96/treje/mapset/types"
+)
+
+type void struct{}
+
+// SetCache - Map set with a bounded size, old or rarely seen elements are evicted
+type SetCache[K comparable] struct {
+	cache Cache[K, void]
+}
+
+// NewSetCache - Create a new bounded set with the given eviction policy
+func NewSetCache[K comparable](policy Policy, options Options) (*SetCache[K], error) {
+	c, err := New[K, void](policy, options)
+	if err != nil {
+		return nil, err
+	}
+	return &SetCache[K]{cache: c}, nil
+}
+
+/*
+	Manipulation set methods
+*/
+
+// Add - Insert an element or refresh it if already present
+func (set *SetCache[K]) Add(elem K) {
+	set.cache.Put(elem, void{})
+}
+
+// Seen - Return true if the element was seen recently, then record it as seen
+func (set *SetCache[K]) Seen(elem K) bool {
+	if _, ok := set.cache.Get(elem); ok {
+		return true
+	}
+	set.cache.Put(elem, void{})
+	return false
+}
+
+// Remove - Remove a specific element from a set, if the element not exists raise an error
+func (set *SetCache[K]) Remove(elem K) error {
+	if set.IsEmpty() {
+		return errors.New(common.EmptySet)
+	}
+	if !set.cache.Delete(elem) {
+		return errors.New(common.ElemNotExist)
+	}
+	return nil
+}
+
+// Discard - Remove a specific element from set
+func (set *SetCache[K]) Discard(elem K) {
+	set.cache.Delete(elem)
+}
+
+/*
+	Utility methods
+*/
+
+// Has - Return true if the element is in set, recording the access, otherwise false
+func (set *SetCache[K]) Has(elem K) bool {
+	_, ok := set.cache.Get(elem)
+	return ok
+}
+
+// Len - Return the number of stored elements, expired ones included until purged
+func (set *SetCache[K]) Len() int {
+	return set.cache.Len()
+}
+
+// IsEmpty - Return true if the set is empty, else false
+func (set *SetCache[K]) IsEmpty() bool {
+	return set.cache.Len() == 0
+}
+
+// Clear - Remove all elements
+func (set *SetCache[K]) Clear() {
+	set.cache.Clear()
+}
+
+// Purge - Remove the expired elements and return how many were removed
+func (set *SetCache[K]) Purge() int {
+	return set.cache.Purge()
+}
+
+// Stats - Return the activity counters
+func (set *SetCache[K]) Stats() Stats {
+	return set.cache.Stats()
+}
+
+// OnEvict - Register a callback called when an element is evicted or expires
+func (set *SetCache[K]) OnEvict(fn func(elem K, reason Reason)) {
+	set.cache.OnEvict(func(key K, _ void, reason Reason) {
+		fn(key, reason)
+	})
+}
+
+/*
+	Methods to manipulate a set object
+*/
+
+// ToSlice - Returns the live elements, the next to be evicted last
+func (set *SetCache[K]) ToSlice() ([]K, error) {
+	if set.IsEmpty() {
+		return nil, errors.New(common.EmptySet)
+	}
+	return set.cache.Keys(), nil
+}
+
+// ToMapSet - Returns the live elements as an unbounded map set
+func (set *SetCache[K]) ToMapSet() mtype.ComparableSet[K] {
+	return mtype.Comparable(set.cache.Keys()...)
+}
@@ -0,0 +1,185 @@
+// Package combinatorics - Lazy generators of subsets, permutations, partitions and products of sets.
+//
+// Like the rest of treje, every operation comes in two forms on purpose: the plain function
+// takes a map set and keeps the values of its elements, the Slices function takes a slice based
+// set of any element type and keeps its order. Both forms walk the same index generators of seq.go,
+// only reading the elements and building the results differ.
+package combinatorics
+
+/*
+	Generators over map sets
+
+	Elements are read when the generator is created, in map order, later changes
+	of the set are not seen. Each yielded set or tuple is a new value the caller may keep.
+*/
+
+// PowerSet - Generate every subset of s, by increasing size
+func PowerSet[T comparable, S ~map[T]V, V any](s S) Seq[S] {
+	elems, values := entries(s)
+	return func(yield func(S) bool) {
+		for k := 0; k <= len(elems); k++ {
+			if !combinations(len(elems), k, func(idx []int) bool { return yield(subset[T, S](elems, values, idx)) }) {
+				return
+			}
+		}
+	}
+}
+
+// Combinations - Generate every subset of s holding k elements
+func Combinations[T comparable, S ~map[T]V, V any](s S, k int) Seq[S] {
+	elems, values := entries(s)
+	return func(yield func(S) bool) {
+		combinations(len(elems), k, func(idx []int) bool { return yield(subset[T, S](elems, values, idx)) })
+	}
+}
+
+// Permutations - Generate every ordered tuple of k distinct elements of s
+func Permutations[T comparable, S ~map[T]V, V any](s S, k int) Seq[[]T] {
+	return PermutationsSlices(keys(s), k)
+}
+
+// Partitions - Generate every way to split s in non empty disjoint blocks, there are Bell(|s|) of them.
+// The empty set has one partition without blocks.
+func Partitions[T comparable, S ~map[T]V, V any](s S) Seq[[]S] {
+	elems, values := entries(s)
+	return func(yield func([]S) bool) {
+		partitions(len(elems), func(block []int, blocks int) bool {
+			result := make([]S, blocks)
+			for b := range result {
+				result[b] = make(S)
+			}
+			for i, b := range block {
+				result[b][elems[i]] = values[i]
+			}
+			return yield(result)
+		})
+	}
+}
+
+// CartesianProduct - Generate every tuple holding one element of each set, in order.
+// Without sets the only tuple is the empty one, an empty set gives no tuple.
+func CartesianProduct[T comparable, S ~map[T]V, V any](sets ...S) Seq[[]T] {
+	elems := make([][]T, len(sets))
+	for i, s := range sets {
+		elems[i] = keys(s)
+	}
+	return productOf(elems)
+}
+
+/*
+	Generators over slice based sets
+
+	Elements are taken in slice order, which is also the order of the yielded subsets and tuples.
+*/
+
+// PowerSetSlices - Same as PowerSet, over a slice based set
+func PowerSetSlices[T any, S ~[]T](s S) Seq[S] {
+	elems := copyOf(s)
+	return func(yield func(S) bool) {
+		for k := 0; k <= len(elems); k++ {
+			if !combinations(len(elems), k, func(idx []int) bool { return yield(pick(elems, idx)) }) {
+				return
+			}
+		}
+	}
+}
+
+// CombinationsSlices - Same as Combinations, over a slice based set
+func CombinationsSlices[T any, S ~[]T](s S, k int) Seq[S] {
+	elems := copyOf(s)
+	return func(yield func(S) bool) {
+		combinations(len(elems), k, func(idx []int) bool { return yield(pick(elems, idx)) })
+	}
+}
+
+// PermutationsSlices - Same as Permutations, over a slice based set
+func PermutationsSlices[T any, S ~[]T](s S, k int) Seq[S] {
+	elems := copyOf(s)
+	return func(yield func(S) bool) {
+		permutations(len(elems), k, func(idx []int) bool { return yield(pick(elems, idx)) })
+	}
+}
+
+// PartitionsSlices - Same as Partitions, over a slice based set
+func PartitionsSlices[T any, S ~[]T](s S) Seq[[]S] {
+	elems := copyOf(s)
+	return func(yield func([]S) bool) {
+		partitions(len(elems), func(block []int, blocks int) bool {
+			result := make([]S, blocks)
+			for i, b := range block {
+				result[b] = append(result[b], elems[i])
+			}
+			return yield(result)
+		})
+	}
+}
+
+// CartesianProductSlices - Same as CartesianProduct, over a slice based set
+func CartesianProductSlices[T any, S ~[]T](sets ...S) Seq[[]T] {
+	elems := make([][]T, len(sets))
+	for i, s := range sets {
+		elems[i] = copyOf(s)
+	}
+	return productOf(elems)
+}
+
+/*
+	Internal helpers
+*/
+
+func productOf[T any](elems [][]T) Seq[[]T] {
+	sizes := make([]int, len(elems))
+	for i, e := range elems {
+		sizes[i] = len(e)
+	}
+	return func(yield func([]T) bool) {
+		product(sizes, func(idx []int) bool {
+			tuple := make([]T, len(idx))
+			for i, j := range idx {
+				tuple[i] = elems[i][j]
+			}
+			return yield(tuple)
+		})
+	}
+}
+
+func keys[T comparable, S ~map[T]V, V any](s S) []T {
+	elems := make([]T, 0, len(s))
+	for e := range s {
+		elems = append(elems, e)
+	}
+	return elems
+}
+
+// entries - Return the elements of s and their values
+func entries[T comparable, S ~map[T]V, V any](s S) ([]T, []V) {
+	elems, values := make([]T, 0, len(s)), make([]V, 0, len(s))
+	for e, v := range s {
+		elems = append(elems, e)
+		values = append(values, v)
+	}
+	return elems, values
+}
+
+func copyOf[T any, S ~[]T](s S) S {
+	elems := make(S, len(s))
+	copy(elems, s)
+	return elems
+}
+
+// subset - Build the map set of the elements at idx, keeping their values
+func subset[T comparable, S ~map[T]V, V any](elems []T, values []V, idx []int) S {
+	result := make(S, len(idx))
+	for _, i := range idx {
+		result[elems[i]] = values[i]
+	}
+	return result
+}
+
+func pick[T any, S ~[]T](elems S, idx []int) S {
+	result := make(S, len(idx))
+	for i, j := range idx {
+		result[i] = elems[j]
+	}
+	return result
+}
@@ -0,0 +1,169 @@
+package combinatorics
+
+// Seq - Lazy sequence, calling it with yield produces the elements one at a time
+// until yield returns false or the sequence ends.
+// Its shape matches iter.Seq, so it can be ranged over with Go 1.23 and later.
+type Seq[E any] func(yield func(E) bool)
+
+// Each - Call fn on each element until it returns false
+func (seq Seq[E]) Each(fn func(E) bool) {
+	seq(fn)
+}
+
+// Count - Return the number of elements, walking the whole sequence
+func (seq Seq[E]) Count() int {
+	n := 0
+	seq(func(E) bool {
+		n++
+		return true
+	})
+	return n
+}
+
+// Take - Return the first n elements at most
+func (seq Seq[E]) Take(n int) []E {
+	result := make([]E, 0)
+	if n <= 0 {
+		return result
+	}
+	seq(func(e E) bool {
+		result = append(result, e)
+		return len(result) < n
+	})
+	return result
+}
+
+// ToSlice - Return all the elements, the whole sequence is held in memory
+func (seq Seq[E]) ToSlice() []E {
+	result := make([]E, 0)
+	seq(func(e E) bool {
+		result = append(result, e)
+		return true
+	})
+	return result
+}
+
+/*
+	Index generators
+
+	They walk tuples of indexes in lexicographic order and reuse the same slice,
+	callers copy what they keep. They return false once yield stopped them.
+*/
+
+// combinations - Walk the increasing k-tuples of indexes below n
+func combinations(n, k int, yield func(idx []int) bool) bool {
+	if k < 0 || k > n {
+		return true
+	}
+	idx := make([]int, k)
+	for i := range idx {
+		idx[i] = i
+	}
+	for {
+		if !yield(idx) {
+			return false
+		}
+		// Find the rightmost index that can still move forward
+		i := k - 1
+		for i >= 0 && idx[i] == n-k+i {
+			i--
+		}
+		if i < 0 {
+			return true
+		}
+		idx[i]++
+		for j := i + 1; j < k; j++ {
+			idx[j] = idx[j-1] + 1
+		}
+	}
+}
+
+// permutations - Walk the k-tuples of distinct indexes below n
+func permutations(n, k int, yield func(idx []int) bool) bool {
+	if k < 0 || k > n {
+		return true
+	}
+	idx := make([]int, k)
+	used := make([]bool, n)
+	var walk func(depth int) bool
+	walk = func(depth int) bool {
+		if depth == k {
+			return yield(idx)
+		}
+		for i := 0; i < n; i++ {
+			if used[i] {
+				continue
+			}
+			used[i] = true
+			idx[depth] = i
+			ok := walk(depth + 1)
+			used[i] = false
+			if !ok {
+				return false
+			}
+		}
+		return true
+	}
+	return walk(0)
+}
+
+// product - Walk the tuples whose i-th index is below sizes[i]
+func product(sizes []int, yield func(idx []int) bool) bool {
+	for _, size := range sizes {
+		if size == 0 {
+			return true
+		}
+	}
+	idx := make([]int, len(sizes))
+	for {
+		if !yield(idx) {
+			return false
+		}
+		// Odometer step, the last position turns fastest
+		i := len(idx) - 1
+		for i >= 0 && idx[i] == sizes[i]-1 {
+			idx[i] = 0
+			i--
+		}
+		if i < 0 {
+			return true
+		}
+		idx[i]++
+	}
+}
+
+// partitions - Walk the set partitions of n indexes as restricted growth strings:
+// index i goes to block block[i], and each block number is at most one more than the previous maximum
+func partitions(n int, yield func(block []int, blocks int) bool) bool {
+	block := make([]int, n)
+	// top[i] is the number of blocks used by the first i+1 indexes
+	top := make([]int, n)
+	for i := range top {
+		top[i] = 1
+	}
+	if n == 0 {
+		return yield(block, 0)
+	}
+	for {
+		if !yield(block, top[n-1]) {
+			return false
+		}
+		// Find the rightmost index that can go to a later block
+		i := n - 1
+		for i > 0 && block[i] == top[i-1] {
+			i--
+		}
+		if i == 0 {
+			return true
+		}
+		block[i]++
+		top[i] = top[i-1]
+		if block[i] == top[i-1] {
+			top[i]++
+		}
+		for j := i + 1; j < n; j++ {
+			block[j] = 0
+			top[j] = top[i]
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package common
+
+type (
+	// Signed - Any signed integer type
+	Signed interface {
+		~int | ~int8 | ~int16 | ~int32 | ~int64
+	}
+
+	// Unsigned - Any unsigned integer type
+	Unsigned interface {
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+	}
+
+	// Integer - Any integer type
+	Integer interface {
+		Signed | Unsigned
+	}
+
+	// Float - Any floating point type
+	Float interface {
+		~float32 | ~float64
+	}
+
+	// Number - Any type that supports the arithmetic used by Sum
+	Number interface {
+		Integer | Float
+	}
+
+	// Ordered - Any type that supports the < <= >= > operators, the same
+	// element types sorted by Sort, Min and Max on the set types
+	Ordered interface {
+		Integer | Float | ~string
+	}
+)
+
+// Comparator - Return a negative number if a < b, zero if a == b and a positive number if a > b
+type Comparator[T any] func(a, b T) int
+
+// Compare - Default Comparator for ordered types
+func Compare[T Ordered](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,91 @@
+package common
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// Hasher - Return a 64 bit hash of an element, equal elements must hash equally
+type Hasher[T any] func(elem T) uint64
+
+const (
+	fnvOffset = 14695981039346656037
+	fnvPrime  = 1099511628211
+)
+
+// Hash - Default Hasher, FNV-1a of the value of basic kinds (named types included).
+// Floats hash like ==, so -0 and +0 collide and every NaN hashes the same.
+// Other kinds fall back to their printed representation.
+func Hash[T any](elem T) uint64 {
+	switch v := any(elem).(type) {
+	case string:
+		return hashString(v)
+	case int:
+		return hashUint(uint64(v))
+	case int64:
+		return hashUint(uint64(v))
+	case uint64:
+		return hashUint(v)
+	case float64:
+		return hashFloat(v)
+	}
+
+	rv := reflect.ValueOf(elem)
+	switch rv.Kind() {
+	case reflect.String:
+		return hashString(rv.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return hashUint(uint64(rv.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return hashUint(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		return hashFloat(rv.Float())
+	case reflect.Bool:
+		if rv.Bool() {
+			return hashUint(1)
+		}
+		return hashUint(0)
+	default:
+		return hashString(fmt.Sprintf("%#v", elem))
+	}
+}
+
+// Mix64 - Scramble the bits of a hash, used to derive independent hashes from one value
+func Mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+func hashString(s string) uint64 {
+	h := uint64(fnvOffset)
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= fnvPrime
+	}
+	return h
+}
+
+func hashUint(v uint64) uint64 {
+	h := uint64(fnvOffset)
+	for i := 0; i < 8; i++ {
+		h ^= v & 0xff
+		h *= fnvPrime
+		v >>= 8
+	}
+	return h
+}
+
+func hashFloat(f float64) uint64 {
+	switch {
+	case f == 0:
+		return hashUint(0)
+	case math.IsNaN(f):
+		return hashUint(math.Float64bits(math.NaN()))
+	}
+	return hashUint(math.Float64bits(f))
+}
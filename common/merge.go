@@ -0,0 +1,31 @@
+package common
+
+// Iterator - Pull iterator, each call returns the next element and false once exhausted
+type Iterator[T any] func() (T, bool)
+
+// MergeSorted - Walk two iterators in ascending order once, calling emit on the elements
+// only in a (onlyA), in both (both) or only in b (onlyB). Elements in both are emitted from a.
+func MergeSorted[T any](a, b Iterator[T], compare Comparator[T], onlyA, both, onlyB bool, emit func(elem T)) {
+	x, okA := a()
+	y, okB := b()
+	for okA || okB {
+		switch {
+		case !okB || (okA && compare(x, y) < 0):
+			if onlyA {
+				emit(x)
+			}
+			x, okA = a()
+		case !okA || compare(x, y) > 0:
+			if onlyB {
+				emit(y)
+			}
+			y, okB = b()
+		default:
+			if both {
+				emit(x)
+			}
+			x, okA = a()
+			y, okB = b()
+		}
+	}
+}
@@ -1,10 +1,39 @@
 package common
 
 const (
-	AlreadyExists   = "already exists in the set"
-	EmptySet        = "set is empty"
-	HasDuplicates   = "set has duplicates"
-	ElemNotExist    = "element does not exist in the set"
-	IndexOutOfRange = "index out of range"
-	CopyEmpty       = "cannot copy an empty slice"
+	AlreadyExists    = "already exists in the set"
+	EmptySet         = "set is empty"
+	HasDuplicates    = "set has duplicates"
+	ElemNotExist     = "element does not exist in the set"
+	IndexOutOfRange  = "index out of range"
+	CopyEmpty        = "cannot copy an empty slice"
+	KeyNotExist      = "key does not exist"
+	NotOrdered       = "keys are not in order"
+	InvalidHeight    = "node height is not consistent"
+	Unbalanced       = "tree is not balanced"
+	InvalidColor     = "red-black coloring is not valid"
+	InvalidSize      = "size is not consistent"
+	VertexNotExist   = "vertex does not exist in the graph"
+	EdgeNotExist     = "edge does not exist in the graph"
+	NotDirected      = "graph is not directed"
+	HasCycle         = "graph has a cycle"
+	NegativeWeight   = "graph has a negative edge weight"
+	NegativeCycle    = "graph has a negative weight cycle"
+	IsDirected       = "graph is directed"
+	NotBipartite     = "graph is not bipartite"
+	PathNotExist     = "path does not exist in the graph"
+	InvalidCapacity  = "capacity must be greater than zero"
+	InvalidRate      = "rate must be between 0 and 1"
+	Incompatible     = "structures are not compatible"
+	InvalidData      = "serialized data is not valid"
+	FilterFull       = "filter is full"
+	InvalidPrecision = "precision is out of range"
+	InvalidRange     = "range bounds are not valid"
+	NotSupported     = "operation is not supported"
+	Overflow         = "result overflows the element type"
+	InvalidQuantile  = "quantile is out of range"
+	InvalidBins      = "number of bins must be greater than zero"
+	NaNNotAllowed    = "NaN is not allowed in the set"
+	InvalidTolerance = "tolerance must not be negative"
+	TooManySets      = "too many sets for inclusion-exclusion"
 )
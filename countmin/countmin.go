@@ -0,0 +1,209 @@
+package countmin
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+
+	"github.com/rojack96/treje/common"
+)
+
+// MaxDepth - Largest number of rows, above the depth of any delta a float64 can express
+const MaxDepth = 1024
+
+// CountMin - Count-Min sketch estimating the frequency of elements in a stream.
+// Estimations never undercount, updates are conservative to limit overcounting.
+type CountMin[T any] struct {
+	table []uint64
+	width uint64
+	depth uint64
+	total uint64
+	hash  common.Hasher[T]
+}
+
+// New - Create a sketch whose estimations exceed the true count by at most epsilon*Total
+// with probability 1-delta
+func New[T common.Ordered](epsilon, delta float64) (*CountMin[T], error) {
+	return NewFunc(epsilon, delta, common.Hash[T])
+}
+
+// NewFunc - Create a sketch for the given error bounds using a custom hash
+func NewFunc[T any](epsilon, delta float64, hash common.Hasher[T]) (*CountMin[T], error) {
+	if epsilon <= 0 || epsilon >= 1 || delta <= 0 || delta >= 1 {
+		return nil, errors.New(common.InvalidRate)
+	}
+
+	width := uint64(math.Ceil(math.E / epsilon))
+	depth := uint64(math.Ceil(math.Log(1 / delta)))
+	return NewSized(width, depth, hash), nil
+}
+
+// NewSized - Create a sketch of depth rows of width counters, depth is capped at MaxDepth.
+// Sketches can be merged only when they share width, depth and the hash.
+func NewSized[T any](width, depth uint64, hash common.Hasher[T]) *CountMin[T] {
+	if width == 0 {
+		width = 1
+	}
+	if depth == 0 {
+		depth = 1
+	}
+	if depth > MaxDepth {
+		depth = MaxDepth
+	}
+	return &CountMin[T]{table: make([]uint64, width*depth), width: width, depth: depth, hash: hash}
+}
+
+/*
+	Manipulation methods
+*/
+
+// Add - Record one occurrence of an element
+func (s *CountMin[T]) Add(elem T) {
+	s.AddCount(elem, 1)
+}
+
+// AddCount - Record n occurrences of an element, raising only the counters that would
+// otherwise end below the new estimation
+func (s *CountMin[T]) AddCount(elem T, n uint64) {
+	if n == 0 {
+		return
+	}
+
+	cells := s.cells(elem)
+	estimate := s.min(cells) + n
+	for _, cell := range cells {
+		if s.table[cell] < estimate {
+			s.table[cell] = estimate
+		}
+	}
+	s.total += n
+}
+
+// Merge - Add the counts recorded by other, both sketches must share their dimensions.
+// The result still never undercounts, sketches of several shards can be combined this way.
+func (s *CountMin[T]) Merge(other *CountMin[T]) error {
+	if s.width != other.width || s.depth != other.depth {
+		return errors.New(common.Incompatible)
+	}
+
+	for i, count := range other.table {
+		s.table[i] += count
+	}
+	s.total += other.total
+	return nil
+}
+
+// Clear - Forget every occurrence
+func (s *CountMin[T]) Clear() {
+	for i := range s.table {
+		s.table[i] = 0
+	}
+	s.total = 0
+}
+
+/*
+	Utility methods
+*/
+
+// Count - Return the estimated number of occurrences of an element
+func (s *CountMin[T]) Count(elem T) uint64 {
+	return s.min(s.cells(elem))
+}
+
+// Total - Return the number of recorded occurrences
+func (s *CountMin[T]) Total() uint64 {
+	return s.total
+}
+
+// IsEmpty - Return true if nothing was recorded, else false
+func (s *CountMin[T]) IsEmpty() bool {
+	return s.total == 0
+}
+
+// Width - Return the number of counters per row
+func (s *CountMin[T]) Width() uint64 {
+	return s.width
+}
+
+// Depth - Return the number of rows
+func (s *CountMin[T]) Depth() uint64 {
+	return s.depth
+}
+
+/*
+	Methods to manipulate a sketch object
+*/
+
+// Copy - Return a deep copy of the sketch
+func (s *CountMin[T]) Copy() *CountMin[T] {
+	c := *s
+	c.table = make([]uint64, len(s.table))
+	copy(c.table, s.table)
+	return &c
+}
+
+// MarshalBinary - Encode the dimensions, the total and the counters of the sketch
+func (s *CountMin[T]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 24+8*len(s.table))
+	binary.BigEndian.PutUint64(data[0:], s.width)
+	binary.BigEndian.PutUint64(data[8:], s.depth)
+	binary.BigEndian.PutUint64(data[16:], s.total)
+	for i, count := range s.table {
+		binary.BigEndian.PutUint64(data[24+8*i:], count)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary - Replace the sketch with encoded data, the hash of the receiver is kept
+// and must be the one used by the encoded sketch
+func (s *CountMin[T]) UnmarshalBinary(data []byte) error {
+	if s.hash == nil || len(data) < 24 || (len(data)-24)%8 != 0 {
+		return errors.New(common.InvalidData)
+	}
+
+	width := binary.BigEndian.Uint64(data[0:])
+	depth := binary.BigEndian.Uint64(data[8:])
+	counters := data[24:]
+	if width == 0 || depth == 0 || depth > MaxDepth {
+		return errors.New(common.InvalidData)
+	}
+	// Divide the payload instead of multiplying the dimensions, which could overflow
+	if n := uint64(len(counters)) / 8; n%width != 0 || n/width != depth {
+		return errors.New(common.InvalidData)
+	}
+
+	s.width, s.depth = width, depth
+	s.total = binary.BigEndian.Uint64(data[16:])
+	s.table = make([]uint64, width*depth)
+	for i := range s.table {
+		s.table[i] = binary.BigEndian.Uint64(counters[8*i:])
+	}
+	return nil
+}
+
+/*
+	Internal helpers
+*/
+
+// cells - Return the counter of the element in each row, derived by double hashing
+func (s *CountMin[T]) cells(elem T) []uint64 {
+	h1 := common.Mix64(s.hash(elem))
+	h2 := common.Mix64(h1) | 1
+
+	cells := make([]uint64, s.depth)
+	for i := range cells {
+		row := uint64(i)
+		cells[i] = row*s.width + (h1+row*h2)%s.width
+	}
+	return cells
+}
+
+func (s *CountMin[T]) min(cells []uint64) uint64 {
+	minimum := s.table[cells[0]]
+	for _, cell := range cells[1:] {
+		if s.table[cell] < minimum {
+			minimum = s.table[cell]
+		}
+	}
+	return minimum
+}
@@ -0,0 +1,68 @@
+package countmin
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func encoded(width, depth uint64, counters int) []byte {
+	data := make([]byte, 24+8*counters)
+	binary.BigEndian.PutUint64(data[0:], width)
+	binary.BigEndian.PutUint64(data[8:], depth)
+	return data
+}
+
+func TestUnmarshalBinaryRoundTrip(t *testing.T) {
+	s, err := New[string](0.01, 0.01)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.AddCount("a", 3)
+	s.Add("b")
+
+	data, err := s.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, _ := New[string](0.5, 0.5)
+	if err := c.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if c.Count("a") != 3 || c.Count("b") != 1 || c.Total() != 4 {
+		t.Fatalf("decoded counts a=%d b=%d total=%d", c.Count("a"), c.Count("b"), c.Total())
+	}
+}
+
+func TestUnmarshalBinaryRejectsBadDimensions(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		// width*depth wraps to zero and used to match the empty body
+		{"overflowing product", encoded(1<<32, 1<<32, 0)},
+		{"overflowing depth", encoded(2, 1<<63, 0)},
+		{"depth above MaxDepth", encoded(1, MaxDepth+1, MaxDepth+1)},
+		{"body not a multiple of width", encoded(3, 1, 2)},
+		{"zero width", encoded(0, 1, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := New[string](0.1, 0.1)
+			if err := s.UnmarshalBinary(tt.data); err == nil {
+				t.Fatal("expected an error")
+			}
+			// The receiver is left usable
+			s.Add("x")
+			if s.Count("x") != 1 {
+				t.Fatalf("count after rejected decode = %d", s.Count("x"))
+			}
+		})
+	}
+}
+
+func TestNewSizedCapsDepth(t *testing.T) {
+	s := NewSized[int](1, MaxDepth+10, nil)
+	if s.Depth() != MaxDepth {
+		t.Fatalf("depth = %d, want %d", s.Depth(), MaxDepth)
+	}
+}
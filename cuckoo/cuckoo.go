@@ -0,0 +1,345 @@
+package cuckoo
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/rojack96/treje/common"
+)
+
+const (
+	// DefaultFingerprintBits - Fingerprint size giving a false positive rate around 0.01%
+	DefaultFingerprintBits = 16
+	// DefaultBucketSize - Slots per bucket, allows a load factor of about 95%
+	DefaultBucketSize = 4
+	// DefaultMaxKicks - Relocations attempted before declaring the filter full
+	DefaultMaxKicks = 500
+
+	maxLoadFactor = 0.95
+	headerSize    = 30
+)
+
+// Options - Tuning of a cuckoo filter, zero values pick the defaults
+type Options struct {
+	// FingerprintBits - Bits stored per element, between 1 and 32
+	FingerprintBits uint
+	// BucketSize - Fingerprints per bucket, between 1 and 255
+	BucketSize int
+	// MaxKicks - Relocations attempted by Add before giving up
+	MaxKicks int
+	// Seed - Seed of the relocation choices, zero picks a random seed
+	Seed int64
+}
+
+// victim - Fingerprint left homeless by a failed relocation, kept so it is never lost
+type victim struct {
+	used        bool
+	fingerprint uint32
+	index       uint64
+}
+
+// CuckooFilter - Probabilistic set supporting deletion
+type CuckooFilter[T any] struct {
+	slots   []uint32
+	buckets uint64
+	options Options
+	count   uint64
+	victim  victim
+	hash    common.Hasher[T]
+	random  *rand.Rand
+	fpMask  uint32
+}
+
+// New - Create a filter able to hold about capacity ordered elements
+func New[T common.Ordered](capacity uint64, options Options) (*CuckooFilter[T], error) {
+	return NewFunc(capacity, options, common.Hash[T])
+}
+
+// NewFunc - Create a filter able to hold about capacity elements using a custom hash
+func NewFunc[T any](capacity uint64, options Options, hash common.Hasher[T]) (*CuckooFilter[T], error) {
+	if capacity == 0 {
+		return nil, errors.New(common.InvalidCapacity)
+	}
+	if options.FingerprintBits == 0 || options.FingerprintBits > 32 {
+		options.FingerprintBits = DefaultFingerprintBits
+	}
+	if options.BucketSize < 1 || options.BucketSize > 255 {
+		options.BucketSize = DefaultBucketSize
+	}
+	if options.MaxKicks < 1 {
+		options.MaxKicks = DefaultMaxKicks
+	}
+	if options.Seed == 0 {
+		options.Seed = time.Now().UnixNano()
+	}
+
+	buckets := uint64(1)
+	for float64(buckets)*float64(options.BucketSize)*maxLoadFactor < float64(capacity) {
+		buckets <<= 1
+	}
+
+	f := &CuckooFilter[T]{buckets: buckets, options: options, hash: hash}
+	f.slots = make([]uint32, buckets*uint64(options.BucketSize))
+	f.random = rand.New(rand.NewSource(options.Seed))
+	f.fpMask = uint32(1<<options.FingerprintBits - 1)
+	return f, nil
+}
+
+// FromMapSet - Create a filter seeded with the elements of a map set
+func FromMapSet[T common.Ordered, S ~map[T]V, V any](set S, options Options) (*CuckooFilter[T], error) {
+	f, err := New[T](uint64(len(set))+1, options)
+	if err != nil {
+		return nil, err
+	}
+	for elem := range set {
+		if err = f.Add(elem); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+/*
+	Manipulation methods
+*/
+
+// Add - Insert an element, raise an error when the filter is too full to place it.
+// Adding the same element twice stores two fingerprints.
+func (f *CuckooFilter[T]) Add(elem T) error {
+	if f.victim.used {
+		return errors.New(common.FilterFull)
+	}
+
+	fp, i1, i2 := f.locate(elem)
+	if f.insert(i1, fp) || f.insert(i2, fp) {
+		f.count++
+		return nil
+	}
+
+	i := i1
+	if f.random.Intn(2) == 1 {
+		i = i2
+	}
+	for kick := 0; kick < f.options.MaxKicks; kick++ {
+		slot := i*uint64(f.options.BucketSize) + uint64(f.random.Intn(f.options.BucketSize))
+		fp, f.slots[slot] = f.slots[slot], fp
+		i = f.alternate(i, fp)
+		if f.insert(i, fp) {
+			f.count++
+			return nil
+		}
+	}
+
+	f.victim = victim{used: true, fingerprint: fp, index: i}
+	f.count++
+	return nil
+}
+
+// Delete - Remove one fingerprint of the element, return true if one was found.
+// Deleting an element that was never added may remove another element.
+func (f *CuckooFilter[T]) Delete(elem T) bool {
+	fp, i1, i2 := f.locate(elem)
+	for _, i := range []uint64{i1, i2} {
+		bucket := f.bucket(i)
+		for j := range bucket {
+			if bucket[j] == fp {
+				bucket[j] = 0
+				f.count--
+				f.reinsertVictim()
+				return true
+			}
+		}
+	}
+
+	if f.victim.used && f.victim.fingerprint == fp && (f.victim.index == i1 || f.victim.index == i2) {
+		f.victim = victim{}
+		f.count--
+		return true
+	}
+	return false
+}
+
+// Clear - Remove all elements
+func (f *CuckooFilter[T]) Clear() {
+	for i := range f.slots {
+		f.slots[i] = 0
+	}
+	f.count = 0
+	f.victim = victim{}
+}
+
+/*
+	Utility methods
+*/
+
+// MayContain - Return false if the element is surely absent, true if it probably is present
+func (f *CuckooFilter[T]) MayContain(elem T) bool {
+	fp, i1, i2 := f.locate(elem)
+	if f.victim.used && f.victim.fingerprint == fp && (f.victim.index == i1 || f.victim.index == i2) {
+		return true
+	}
+	for _, i := range []uint64{i1, i2} {
+		for _, stored := range f.bucket(i) {
+			if stored == fp {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Len - Return the number of stored fingerprints
+func (f *CuckooFilter[T]) Len() uint64 {
+	return f.count
+}
+
+// IsEmpty - Return true if the filter is empty, else false
+func (f *CuckooFilter[T]) IsEmpty() bool {
+	return f.count == 0
+}
+
+// Capacity - Return the number of slots of the filter
+func (f *CuckooFilter[T]) Capacity() uint64 {
+	return uint64(len(f.slots))
+}
+
+// LoadFactor - Return the fraction of slots in use
+func (f *CuckooFilter[T]) LoadFactor() float64 {
+	return float64(f.count) / float64(len(f.slots))
+}
+
+/*
+	Methods to manipulate a filter object
+*/
+
+// Copy - Return a deep copy of the filter
+func (f *CuckooFilter[T]) Copy() *CuckooFilter[T] {
+	c := *f
+	c.slots = make([]uint32, len(f.slots))
+	copy(c.slots, f.slots)
+	c.random = rand.New(rand.NewSource(f.random.Int63()))
+	return &c
+}
+
+// MarshalBinary - Encode the layout and the fingerprints of the filter
+func (f *CuckooFilter[T]) MarshalBinary() ([]byte, error) {
+	width := f.width()
+	data := make([]byte, headerSize+width*len(f.slots))
+
+	data[0] = byte(f.options.FingerprintBits)
+	data[1] = byte(f.options.BucketSize)
+	binary.BigEndian.PutUint64(data[2:], f.buckets)
+	binary.BigEndian.PutUint64(data[10:], f.count)
+	binary.BigEndian.PutUint64(data[18:], f.victim.index)
+	binary.BigEndian.PutUint32(data[26:], f.victim.fingerprint)
+
+	for i, fp := range f.slots {
+		for b := 0; b < width; b++ {
+			data[headerSize+i*width+b] = byte(fp >> (8 * (width - 1 - b)))
+		}
+	}
+	return data, nil
+}
+
+// UnmarshalBinary - Replace the filter with encoded data, the hash of the receiver is kept
+// and must be the one used by the encoded filter
+func (f *CuckooFilter[T]) UnmarshalBinary(data []byte) error {
+	if f.hash == nil || len(data) < headerSize {
+		return errors.New(common.InvalidData)
+	}
+
+	bits, size := uint(data[0]), int(data[1])
+	buckets := binary.BigEndian.Uint64(data[2:])
+	width := int(bits+7) / 8
+	if bits == 0 || bits > 32 || size == 0 || buckets == 0 || buckets&(buckets-1) != 0 {
+		return errors.New(common.InvalidData)
+	}
+	// Divide the payload instead of multiplying the layout, which could overflow
+	body, slot := uint64(len(data)-headerSize), uint64(size)*uint64(width)
+	if body%slot != 0 || body/slot != buckets {
+		return errors.New(common.InvalidData)
+	}
+
+	mask := uint32(1<<bits - 1)
+	count := binary.BigEndian.Uint64(data[10:])
+	v := victim{index: binary.BigEndian.Uint64(data[18:]), fingerprint: binary.BigEndian.Uint32(data[26:])}
+	v.used = v.fingerprint != 0
+	// The count includes the victim, which is held outside the slots
+	limit := buckets * uint64(size)
+	if v.used {
+		limit++
+	}
+	if count > limit || (v.used && (v.index >= buckets || v.fingerprint&^mask != 0)) {
+		return errors.New(common.InvalidData)
+	}
+
+	f.options.FingerprintBits, f.options.BucketSize = bits, size
+	f.buckets = buckets
+	f.fpMask = mask
+	f.count = count
+	f.victim = v
+	if f.random == nil {
+		f.random = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	f.slots = make([]uint32, buckets*uint64(size))
+	for i := range f.slots {
+		for b := 0; b < width; b++ {
+			f.slots[i] = f.slots[i]<<8 | uint32(data[headerSize+i*width+b])
+		}
+	}
+	return nil
+}
+
+/*
+	Internal helpers
+*/
+
+// locate - Return the fingerprint and the two candidate buckets of an element
+func (f *CuckooFilter[T]) locate(elem T) (uint32, uint64, uint64) {
+	h := common.Mix64(f.hash(elem))
+	fp := uint32(h>>32) & f.fpMask
+	if fp == 0 {
+		fp = 1
+	}
+	i1 := h & (f.buckets - 1)
+	return fp, i1, f.alternate(i1, fp)
+}
+
+// alternate - Return the other bucket of a fingerprint, the relation is symmetric
+func (f *CuckooFilter[T]) alternate(i uint64, fp uint32) uint64 {
+	return (i ^ common.Mix64(uint64(fp))) & (f.buckets - 1)
+}
+
+func (f *CuckooFilter[T]) bucket(i uint64) []uint32 {
+	start := i * uint64(f.options.BucketSize)
+	return f.slots[start : start+uint64(f.options.BucketSize)]
+}
+
+func (f *CuckooFilter[T]) insert(i uint64, fp uint32) bool {
+	bucket := f.bucket(i)
+	for j := range bucket {
+		if bucket[j] == 0 {
+			bucket[j] = fp
+			return true
+		}
+	}
+	return false
+}
+
+// reinsertVictim - Try to move the homeless fingerprint into the room freed by a delete
+func (f *CuckooFilter[T]) reinsertVictim() {
+	if !f.victim.used {
+		return
+	}
+	v := f.victim
+	if f.insert(v.index, v.fingerprint) || f.insert(f.alternate(v.index, v.fingerprint), v.fingerprint) {
+		f.victim = victim{}
+	}
+}
+
+func (f *CuckooFilter[T]) width() int {
+	return int(f.options.FingerprintBits+7) / 8
+}
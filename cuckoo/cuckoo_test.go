@@ -0,0 +1,67 @@
+package cuckoo
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func TestUnmarshalBinaryRoundTrip(t *testing.T) {
+	f, err := New[int](100, Options{Seed: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 50; i++ {
+		if err := f.Add(i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	data, _ := f.MarshalBinary()
+
+	g, _ := New[int](10, Options{Seed: 2})
+	if err := g.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if g.Len() != f.Len() {
+		t.Fatalf("len = %d, want %d", g.Len(), f.Len())
+	}
+	for i := 0; i < 50; i++ {
+		if !g.MayContain(i) {
+			t.Fatalf("decoded filter lost %d", i)
+		}
+	}
+}
+
+func TestUnmarshalBinaryRejectsCorruptHeader(t *testing.T) {
+	tests := []struct {
+		name    string
+		corrupt func(data []byte)
+	}{
+		{"victim index out of range", func(data []byte) {
+			binary.BigEndian.PutUint64(data[18:], 1<<40)
+			binary.BigEndian.PutUint32(data[26:], 5)
+		}},
+		{"victim fingerprint too wide", func(data []byte) {
+			binary.BigEndian.PutUint32(data[26:], 1<<31)
+		}},
+		{"count above the slots", func(data []byte) {
+			binary.BigEndian.PutUint64(data[10:], 1<<40)
+		}},
+		// buckets*slot used to overflow and match a short body
+		{"overflowing layout", func(data []byte) {
+			binary.BigEndian.PutUint64(data[2:], 1<<62)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _ := New[int](100, Options{Seed: 1})
+			f.Add(1)
+			data, _ := f.MarshalBinary()
+			tt.corrupt(data)
+
+			g, _ := New[int](10, Options{Seed: 1})
+			if err := g.UnmarshalBinary(data); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package disjointset
+
+import (
+	"errors"
+
+	"github.com/rojack96/treje/common"
+	mtype "github.com/rojack96/treje/mapset/types"
+)
+
+// DisjointSet - Union-Find structure with path compression and union by rank
+type DisjointSet[T comparable] struct {
+	parent map[T]T
+	rank   map[T]int
+	size   map[T]int
+	groups int
+}
+
+// New - Create a new disjoint set, each element starts in its own group
+func New[T comparable](elems ...T) *DisjointSet[T] {
+	ds := &DisjointSet[T]{
+		parent: make(map[T]T, len(elems)),
+		rank:   map[T]int{},
+		size:   make(map[T]int, len(elems)),
+	}
+	for _, e := range elems {
+		ds.Add(e)
+	}
+	return ds
+}
+
+/*
+	Manipulation methods
+*/
+
+// Add - Add an element in a new group of its own, return false if it was already present
+func (ds *DisjointSet[T]) Add(elem T) bool {
+	if ds.Has(elem) {
+		return false
+	}
+	ds.parent[elem] = elem
+	ds.size[elem] = 1
+	ds.groups++
+	return true
+}
+
+// Union - Merge the groups of a and b, return true if they were in different groups
+func (ds *DisjointSet[T]) Union(a, b T) (bool, error) {
+	rootA, err := ds.Find(a)
+	if err != nil {
+		return false, err
+	}
+	rootB, err := ds.Find(b)
+	if err != nil {
+		return false, err
+	}
+	if rootA == rootB {
+		return false, nil
+	}
+
+	if ds.rank[rootA] < ds.rank[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+	ds.parent[rootB] = rootA
+	ds.size[rootA] += ds.size[rootB]
+	delete(ds.size, rootB)
+	if ds.rank[rootA] == ds.rank[rootB] {
+		ds.rank[rootA]++
+	}
+	delete(ds.rank, rootB)
+	ds.groups--
+	return true, nil
+}
+
+// Clear - Remove all elements
+func (ds *DisjointSet[T]) Clear() {
+	*ds = *New[T]()
+}
+
+/*
+	Utility methods
+*/
+
+// Find - Return the representative of the group of elem
+func (ds *DisjointSet[T]) Find(elem T) (T, error) {
+	root, ok := ds.parent[elem]
+	if !ok {
+		return root, errors.New(common.ElemNotExist)
+	}
+	for root != ds.parent[root] {
+		root = ds.parent[root]
+	}
+
+	for elem != root {
+		next := ds.parent[elem]
+		ds.parent[elem] = root
+		elem = next
+	}
+	return root, nil
+}
+
+// Connected - Return true if a and b are in the same group, otherwise false
+func (ds *DisjointSet[T]) Connected(a, b T) bool {
+	rootA, errA := ds.Find(a)
+	rootB, errB := ds.Find(b)
+	return errA == nil && errB == nil && rootA == rootB
+}
+
+// SetSize - Return the number of elements in the group of elem
+func (ds *DisjointSet[T]) SetSize(elem T) (int, error) {
+	root, err := ds.Find(elem)
+	if err != nil {
+		return 0, err
+	}
+	return ds.size[root], nil
+}
+
+// Has - Return true if the element is in the structure, otherwise false
+func (ds *DisjointSet[T]) Has(elem T) bool {
+	_, ok := ds.parent[elem]
+	return ok
+}
+
+// Len - Return the number of elements
+func (ds *DisjointSet[T]) Len() int {
+	return len(ds.parent)
+}
+
+// Count - Return the number of groups
+func (ds *DisjointSet[T]) Count() int {
+	return ds.groups
+}
+
+// IsEmpty - Return true if there are no elements, else false
+func (ds *DisjointSet[T]) IsEmpty() bool {
+	return len(ds.parent) == 0
+}
+
+// Groups - Return every group as a map set
+func (ds *DisjointSet[T]) Groups() []mtype.ComparableSet[T] {
+	index := make(map[T]int, ds.groups)
+	result := make([]mtype.ComparableSet[T], 0, ds.groups)
+
+	for elem := range ds.parent {
+		root, _ := ds.Find(elem)
+		i, ok := index[root]
+		if !ok {
+			i = len(result)
+			index[root] = i
+			result = append(result, make(mtype.ComparableSet[T], ds.size[root]))
+		}
+		result[i].Add(elem)
+	}
+	return result
+}
@@ -0,0 +1,151 @@
+package fenwick
+
+import (
+	"errors"
+
+	"github.com/rojack96/treje/common"
+)
+
+// FenwickTree - Binary indexed tree maintaining prefix sums of an array under point updates
+type FenwickTree[T common.Number] struct {
+	// tree[i] holds the sum of the values in (i - i&-i, i], indexes start at 1
+	tree []T
+}
+
+// New - Create a tree of n zero values
+func New[T common.Number](n int) *FenwickTree[T] {
+	if n < 0 {
+		n = 0
+	}
+	return &FenwickTree[T]{tree: make([]T, n+1)}
+}
+
+// FromSlice - Create a tree over the values of a slice in linear time
+func FromSlice[T common.Number, S ~[]T](values S) *FenwickTree[T] {
+	f := New[T](len(values))
+	copy(f.tree[1:], values)
+	for i := 1; i < len(f.tree); i++ {
+		if parent := i + i&-i; parent < len(f.tree) {
+			f.tree[parent] += f.tree[i]
+		}
+	}
+	return f
+}
+
+/*
+	Manipulation methods
+*/
+
+// Add - Add delta to the value at index i
+func (f *FenwickTree[T]) Add(i int, delta T) error {
+	if i < 0 || i >= f.Len() {
+		return errors.New(common.IndexOutOfRange)
+	}
+	for i++; i < len(f.tree); i += i & -i {
+		f.tree[i] += delta
+	}
+	return nil
+}
+
+// Set - Replace the value at index i
+func (f *FenwickTree[T]) Set(i int, value T) error {
+	current, err := f.Get(i)
+	if err != nil {
+		return err
+	}
+	return f.Add(i, value-current)
+}
+
+// Clear - Reset every value to zero
+func (f *FenwickTree[T]) Clear() {
+	for i := range f.tree {
+		f.tree[i] = 0
+	}
+}
+
+/*
+	Query methods
+*/
+
+// PrefixSum - Return the sum of the values of [0, i)
+func (f *FenwickTree[T]) PrefixSum(i int) (T, error) {
+	if i < 0 || i > f.Len() {
+		return 0, errors.New(common.IndexOutOfRange)
+	}
+	var sum T
+	for ; i > 0; i -= i & -i {
+		sum += f.tree[i]
+	}
+	return sum, nil
+}
+
+// RangeSum - Return the sum of the values of [lo, hi)
+func (f *FenwickTree[T]) RangeSum(lo, hi int) (T, error) {
+	if lo > hi {
+		return 0, errors.New(common.IndexOutOfRange)
+	}
+	high, err := f.PrefixSum(hi)
+	if err != nil {
+		return 0, err
+	}
+	low, err := f.PrefixSum(lo)
+	if err != nil {
+		return 0, err
+	}
+	return high - low, nil
+}
+
+// Get - Return the value at index i
+func (f *FenwickTree[T]) Get(i int) (T, error) {
+	return f.RangeSum(i, i+1)
+}
+
+// Sum - Return the sum of every value
+func (f *FenwickTree[T]) Sum() T {
+	sum, _ := f.PrefixSum(f.Len())
+	return sum
+}
+
+// LowerBound - Return the smallest i such that the sum of [0, i] is at least target,
+// Len if there is none. Values must not be negative.
+func (f *FenwickTree[T]) LowerBound(target T) int {
+	step := 1
+	for step*2 < len(f.tree) {
+		step *= 2
+	}
+
+	pos := 0
+	for ; step > 0; step /= 2 {
+		if next := pos + step; next < len(f.tree) && f.tree[next] < target {
+			pos = next
+			target -= f.tree[next]
+		}
+	}
+	return pos
+}
+
+// Len - Return the number of values
+func (f *FenwickTree[T]) Len() int {
+	return len(f.tree) - 1
+}
+
+// IsEmpty - Return true if the tree has no value, else false
+func (f *FenwickTree[T]) IsEmpty() bool {
+	return f.Len() == 0
+}
+
+// Values - Return the values of the array
+func (f *FenwickTree[T]) Values() []T {
+	values := make([]T, f.Len())
+	for i := range values {
+		values[i], _ = f.Get(i)
+	}
+	return values
+}
+
+// Copy - Return a deep copy of the tree
+func (f *FenwickTree[T]) Copy() *FenwickTree[T] {
+	c := &FenwickTree[T]{tree: make([]T, len(f.tree))}
+	copy(c.tree, f.tree)
+	return c
+}
@@ -0,0 +1,335 @@
+package floatset
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/rojack96/treje/common"
+)
+
+// FloatSet - Sorted set of floats with explicit rules for NaN, signed zeros and tolerance.
+// With a tolerance, an element matches every value close enough to it and
+// Add refuses values matching an element already present.
+type FloatSet[T common.Float] struct {
+	elems   []T
+	nan     bool
+	options Options
+}
+
+// New - Create a new set with the given options, empty or from a slice
+func New[T common.Float](options Options, elems ...T) (*FloatSet[T], error) {
+	if !(options.Epsilon >= 0) {
+		return nil, errors.New(common.InvalidTolerance)
+	}
+	set := &FloatSet[T]{options: options}
+	for _, e := range elems {
+		if e != e && options.NaN == RejectNaN {
+			return nil, errors.New(common.NaNNotAllowed)
+		}
+		if set.Add(e) != nil {
+			return nil, errors.New(common.HasDuplicates)
+		}
+	}
+	return set, nil
+}
+
+// FromSlice - Create a new set from a slice based set
+func FromSlice[T common.Float, S ~[]T](options Options, set S) (*FloatSet[T], error) {
+	return New(options, set...)
+}
+
+// FromMapSet - Create a new set from a map set
+func FromMapSet[T common.Float, S ~map[T]V, V any](options Options, set S) (*FloatSet[T], error) {
+	elems := make([]T, 0, len(set))
+	for e := range set {
+		elems = append(elems, e)
+	}
+	return New(options, elems...)
+}
+
+/*
+	Manipulation set methods
+*/
+
+// Add - Append a new element to the set if and only if no element matches it
+func (set *FloatSet[T]) Add(elem T) error {
+	if elem != elem {
+		switch {
+		case set.options.NaN == RejectNaN:
+			return errors.New(common.NaNNotAllowed)
+		case set.nan:
+			return errors.New("NaN " + common.AlreadyExists)
+		}
+		set.nan = true
+		return nil
+	}
+
+	if _, ok := set.find(elem); ok {
+		return errors.New(fmt.Sprint(elem) + " " + common.AlreadyExists)
+	}
+	i := sort.Search(len(set.elems), func(i int) bool { return !set.less(set.elems[i], elem) })
+	set.elems = append(set.elems, 0)
+	copy(set.elems[i+1:], set.elems[i:])
+	set.elems[i] = elem
+	return nil
+}
+
+// Remove - Remove the element matching elem, if there is none raise an error
+func (set *FloatSet[T]) Remove(elem T) error {
+	if set.IsEmpty() {
+		return errors.New(common.EmptySet)
+	}
+	if !set.remove(elem) {
+		return errors.New(common.ElemNotExist)
+	}
+	return nil
+}
+
+// Discard - Remove the element matching elem if there is one
+func (set *FloatSet[T]) Discard(elem T) {
+	set.remove(elem)
+}
+
+/*
+	Set operation methods
+
+	The result uses the options of the receiver: elements of b those options reject,
+	such as NaN when the receiver uses RejectNaN, are dropped.
+*/
+
+// Union - Returns a new set with the elements of both sets,
+// elements of b matching an element of the receiver or rejected by its options are left out
+func (set *FloatSet[T]) Union(b *FloatSet[T]) *FloatSet[T] {
+	result := set.clone()
+	b.Each(func(elem T) bool {
+		_ = result.Add(elem)
+		return true
+	})
+	return result
+}
+
+// Intersect - Returns the elements of the receiver matching an element of b
+func (set *FloatSet[T]) Intersect(b *FloatSet[T]) *FloatSet[T] {
+	return set.filter(b.Has)
+}
+
+// Difference - Returns the elements of the receiver matching no element of b
+func (set *FloatSet[T]) Difference(b *FloatSet[T]) *FloatSet[T] {
+	return set.filter(func(elem T) bool { return !b.Has(elem) })
+}
+
+// SymmetricDifference - Returns a new set with the elements of either set matching no element of the other,
+// elements of b rejected by the options of the receiver are left out
+func (set *FloatSet[T]) SymmetricDifference(b *FloatSet[T]) *FloatSet[T] {
+	result := set.Difference(b)
+	b.Each(func(elem T) bool {
+		if !set.Has(elem) {
+			_ = result.Add(elem)
+		}
+		return true
+	})
+	return result
+}
+
+// IsSubsetOf - Return true if every element matches an element of b, else false
+func (set *FloatSet[T]) IsSubsetOf(b *FloatSet[T]) bool {
+	subset := true
+	set.Each(func(elem T) bool {
+		subset = b.Has(elem)
+		return subset
+	})
+	return subset
+}
+
+// Equals - Return true if both sets have the same length and every element matches one of b
+func (set *FloatSet[T]) Equals(b *FloatSet[T]) bool {
+	return set.Len() == b.Len() && set.IsSubsetOf(b)
+}
+
+/*
+	Lookup methods
+*/
+
+// Has - Return true if an element matches elem, else false
+func (set *FloatSet[T]) Has(elem T) bool {
+	_, ok := set.Find(elem)
+	return ok
+}
+
+// Find - Return the element matching elem, the closest one if several do
+func (set *FloatSet[T]) Find(elem T) (T, bool) {
+	if elem != elem {
+		return elem, set.nan
+	}
+	i, ok := set.find(elem)
+	if !ok {
+		return 0, false
+	}
+	return set.elems[i], true
+}
+
+// HasNaN - Return true if the set holds NaN, else false
+func (set *FloatSet[T]) HasNaN() bool {
+	return set.nan
+}
+
+// Len - Return the number of elements, NaN included
+func (set *FloatSet[T]) Len() int {
+	if set.nan {
+		return len(set.elems) + 1
+	}
+	return len(set.elems)
+}
+
+// IsEmpty - Return true if the set is empty, else false
+func (set *FloatSet[T]) IsEmpty() bool {
+	return set.Len() == 0
+}
+
+// Clear - Remove all elements from the set
+func (set *FloatSet[T]) Clear() {
+	set.elems = nil
+	set.nan = false
+}
+
+// Min - Return the smallest element, NaN is not ordered and left out
+func (set *FloatSet[T]) Min() (T, error) {
+	if len(set.elems) == 0 {
+		return 0, errors.New(common.EmptySet)
+	}
+	return set.elems[0], nil
+}
+
+// Max - Return the largest element, NaN is not ordered and left out
+func (set *FloatSet[T]) Max() (T, error) {
+	if len(set.elems) == 0 {
+		return 0, errors.New(common.EmptySet)
+	}
+	return set.elems[len(set.elems)-1], nil
+}
+
+// Options - Return the equality rules of the set
+func (set *FloatSet[T]) Options() Options {
+	return set.options
+}
+
+/*
+	Methods to manipulate a set object
+*/
+
+// Each - Call fn on each element until it returns false, NaN first and then in ascending order
+func (set *FloatSet[T]) Each(fn func(elem T) bool) {
+	if set.nan && !fn(T(math.NaN())) {
+		return
+	}
+	for _, e := range set.elems {
+		if !fn(e) {
+			return
+		}
+	}
+}
+
+// Copy - Return an independent copy of the set with the same options
+func (set *FloatSet[T]) Copy() (*FloatSet[T], error) {
+	if set.IsEmpty() {
+		return nil, errors.New(common.CopyEmpty)
+	}
+	return set.clone(), nil
+}
+
+// ToSlice - Return the elements as a slice, NaN first and then in ascending order
+func (set *FloatSet[T]) ToSlice() ([]T, error) {
+	if set.IsEmpty() {
+		return nil, errors.New(common.EmptySet)
+	}
+	result := make([]T, 0, set.Len())
+	set.Each(func(elem T) bool {
+		result = append(result, elem)
+		return true
+	})
+	return result, nil
+}
+
+/*
+	Internal helpers
+*/
+
+// find - Return the index of the closest element matching elem, which is not NaN.
+// Elements near elem are contiguous around its position, so the search stops at the first one too far.
+func (set *FloatSet[T]) find(elem T) (int, bool) {
+	single, x := is32[T](), float64(elem)
+	i := sort.Search(len(set.elems), func(i int) bool { return !set.less(set.elems[i], elem) })
+
+	best, distance := -1, math.Inf(1)
+	consider := func(j int) bool {
+		e := float64(set.elems[j])
+		if !set.options.near(e, x, single) {
+			return false
+		}
+		if d := math.Abs(e - x); set.options.equal(e, x, single) && (best < 0 || d < distance) {
+			best, distance = j, d
+		}
+		return true
+	}
+	for j := i; j < len(set.elems); j++ {
+		if !consider(j) {
+			break
+		}
+	}
+	for j := i - 1; j >= 0; j-- {
+		if !consider(j) {
+			break
+		}
+	}
+	return best, best >= 0
+}
+
+func (set *FloatSet[T]) clone() *FloatSet[T] {
+	elems := make([]T, len(set.elems))
+	copy(elems, set.elems)
+	return &FloatSet[T]{elems: elems, nan: set.nan, options: set.options}
+}
+
+func (set *FloatSet[T]) remove(elem T) bool {
+	if elem != elem {
+		found := set.nan
+		set.nan = false
+		return found
+	}
+	i, ok := set.find(elem)
+	if ok {
+		set.elems = append(set.elems[:i], set.elems[i+1:]...)
+	}
+	return ok
+}
+
+// less - Order of the elements, -0 sorts before +0 when they are distinct
+func (set *FloatSet[T]) less(a, b T) bool {
+	if a == 0 && b == 0 && set.options.Zero == DistinctZeros {
+		return math.Signbit(float64(a)) && !math.Signbit(float64(b))
+	}
+	return a < b
+}
+
+func (set *FloatSet[T]) filter(keep func(elem T) bool) *FloatSet[T] {
+	result := &FloatSet[T]{options: set.options}
+	set.Each(func(elem T) bool {
+		if keep(elem) {
+			if elem != elem {
+				result.nan = true
+			} else {
+				result.elems = append(result.elems, elem)
+			}
+		}
+		return true
+	})
+	return result
+}
+
+// is32 - Return true if T has single precision, the smallest float64 underflows to zero in it
+func is32[T common.Float]() bool {
+	tiny := math.SmallestNonzeroFloat64
+	return T(tiny) == 0
+}
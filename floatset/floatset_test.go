@@ -0,0 +1,23 @@
+package floatset
+
+import (
+	"math"
+	"testing"
+)
+
+func TestUnionDropsRejectedNaN(t *testing.T) {
+	a, _ := New(Options{}, 1.0)
+	b, _ := New(Options{NaN: SingleNaN}, 2.0, math.NaN())
+
+	union := a.Union(b)
+	if union.HasNaN() || union.Len() != 2 || !union.Has(2) {
+		t.Fatalf("union holds %d elements, NaN = %v", union.Len(), union.HasNaN())
+	}
+	if diff := a.SymmetricDifference(b); diff.HasNaN() || diff.Len() != 2 {
+		t.Fatalf("symmetric difference holds %d elements, NaN = %v", diff.Len(), diff.HasNaN())
+	}
+	// With the policies swapped NaN is kept
+	if union := b.Union(a); !union.HasNaN() || union.Len() != 3 {
+		t.Fatalf("union holds %d elements, NaN = %v", union.Len(), union.HasNaN())
+	}
+}
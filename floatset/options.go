@@ -0,0 +1,84 @@
+package floatset
+
+import "math"
+
+// NaNPolicy - How a set treats NaN, which is not equal to itself
+type NaNPolicy int
+
+const (
+	// RejectNaN - Adding NaN raises an error
+	RejectNaN NaNPolicy = iota
+	// SingleNaN - Every NaN is the same element, the set holds at most one
+	SingleNaN
+)
+
+// ZeroPolicy - How a set treats the two signed zeros
+type ZeroPolicy int
+
+const (
+	// MergeZeros - -0 and +0 are the same element, as with ==, the first one added is kept
+	MergeZeros ZeroPolicy = iota
+	// DistinctZeros - -0 and +0 are different elements, -0 sorts first
+	DistinctZeros
+)
+
+// Options - Equality rules of a set, the zero value gives exact comparison without NaN
+type Options struct {
+	// NaN - Policy for NaN elements
+	NaN NaNPolicy
+	// Zero - Policy for signed zeros
+	Zero ZeroPolicy
+	// Epsilon - Values at most Epsilon apart are the same element
+	Epsilon float64
+	// ULPs - Values with at most ULPs representable floats between them are the same element
+	ULPs uint64
+}
+
+// equal - Return true if a and b are the same element, NaN is never equal.
+// Infinities only equal themselves. With both tolerances set, either one is enough.
+// DistinctZeros keeps -0 and +0 apart even when a tolerance would join them.
+func (o Options) equal(a, b float64, single bool) bool {
+	if o.Zero == DistinctZeros && a == 0 && b == 0 {
+		return math.Signbit(a) == math.Signbit(b)
+	}
+	return o.near(a, b, single)
+}
+
+// near - Tolerance test without the zero policy, distances grow monotonically on both sides of a value
+func (o Options) near(a, b float64, single bool) bool {
+	switch {
+	case a == b:
+		return true
+	case math.IsInf(a, 0) || math.IsInf(b, 0) || a != a || b != b:
+		return false
+	case o.Epsilon > 0 && math.Abs(a-b) <= o.Epsilon:
+		return true
+	case o.ULPs > 0:
+		return ulps(a, b, single) <= o.ULPs
+	}
+	return false
+}
+
+// ulps - Return the number of representable floats from a to b, in single or double precision
+func ulps(a, b float64, single bool) uint64 {
+	ka, kb := key(a, single), key(b, single)
+	if ka < kb {
+		ka, kb = kb, ka
+	}
+	// The difference may not fit an int64, it always fits an uint64
+	return uint64(ka) - uint64(kb)
+}
+
+// key - Map a float to an integer preserving order, both zeros map to 0
+func key(x float64, single bool) int64 {
+	var bits, sign uint64
+	if single {
+		bits, sign = uint64(math.Float32bits(float32(x))), 1<<31
+	} else {
+		bits, sign = math.Float64bits(x), 1<<63
+	}
+	if bits&sign != 0 {
+		return -int64(bits &^ sign)
+	}
+	return int64(bits)
+}
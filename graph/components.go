@@ -0,0 +1,109 @@
+package graph
+
+import (
+	mtype "github.com/rojack96/treje/mapset/types"
+)
+
+// Tarjan - Return the strongly connected components with Tarjan's single pass algorithm.
+// Components are listed in reverse topological order of the condensed graph.
+func (g *Graph[V, W]) Tarjan() []mtype.ComparableSet[V] {
+	var (
+		result  = make([]mtype.ComparableSet[V], 0)
+		index   = map[V]int{}
+		low     = map[V]int{}
+		onStack = map[V]bool{}
+		stack   []V
+		connect func(v V)
+	)
+
+	connect = func(v V) {
+		index[v] = len(index)
+		low[v] = index[v]
+		stack = append(stack, v)
+		onStack[v] = true
+
+		for u := range g.out[v] {
+			if _, visited := index[u]; !visited {
+				connect(u)
+				if low[u] < low[v] {
+					low[v] = low[u]
+				}
+			} else if onStack[u] && index[u] < low[v] {
+				low[v] = index[u]
+			}
+		}
+
+		if low[v] != index[v] {
+			return
+		}
+
+		component := mtype.Comparable[V]()
+		for {
+			u := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[u] = false
+			component.Add(u)
+			if u == v {
+				break
+			}
+		}
+		result = append(result, component)
+	}
+
+	for v := range g.out {
+		if _, visited := index[v]; !visited {
+			connect(v)
+		}
+	}
+	return result
+}
+
+// Kosaraju - Return the strongly connected components with Kosaraju's two pass algorithm.
+// Components are listed in topological order of the condensed graph.
+func (g *Graph[V, W]) Kosaraju() []mtype.ComparableSet[V] {
+	var (
+		seen  = map[V]bool{}
+		order = make([]V, 0, len(g.out))
+		visit func(v V)
+	)
+
+	visit = func(v V) {
+		seen[v] = true
+		for u := range g.out[v] {
+			if !seen[u] {
+				visit(u)
+			}
+		}
+		order = append(order, v)
+	}
+	for v := range g.out {
+		if !seen[v] {
+			visit(v)
+		}
+	}
+
+	result := make([]mtype.ComparableSet[V], 0)
+	assigned := map[V]bool{}
+	for i := len(order) - 1; i >= 0; i-- {
+		if assigned[order[i]] {
+			continue
+		}
+
+		component := mtype.Comparable[V]()
+		stack := []V{order[i]}
+		assigned[order[i]] = true
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			component.Add(v)
+			for u := range g.in[v] {
+				if !assigned[u] {
+					assigned[u] = true
+					stack = append(stack, u)
+				}
+			}
+		}
+		result = append(result, component)
+	}
+	return result
+}
@@ -0,0 +1,233 @@
+package graph
+
+import (
+	"errors"
+
+	"github.com/rojack96/treje/common"
+	mtype "github.com/rojack96/treje/mapset/types"
+)
+
+// Flow - Maximum flow between a source and a sink, edge weights are the capacities
+type Flow[V comparable, W common.Number] struct {
+	Value    W
+	Source   V
+	Sink     V
+	vertices []V
+	arcs     []arc[W]
+	adj      [][]int
+}
+
+// arc - Residual arc, its reverse arc is stored at index id^1.
+// flow is never negative, so unsigned weights work: flow sent back along an arc
+// cancels the flow of its reverse arc first.
+type arc[W common.Number] struct {
+	to       int
+	capacity W
+	flow     W
+}
+
+// EdmondsKarp - Compute the maximum flow with shortest augmenting paths
+func (g *Graph[V, W]) EdmondsKarp(source, sink V) (*Flow[V, W], error) {
+	f, s, t, err := g.network(source, sink)
+	if err != nil || s == t {
+		return f, err
+	}
+
+	for {
+		parent := f.levels(s, true)
+		if parent[t] == -1 {
+			return f, nil
+		}
+
+		bottleneck := f.residual(parent[t])
+		for v := t; v != s; v = f.arcs[parent[v]^1].to {
+			if r := f.residual(parent[v]); r < bottleneck {
+				bottleneck = r
+			}
+		}
+		for v := t; v != s; v = f.arcs[parent[v]^1].to {
+			f.push(parent[v], bottleneck)
+		}
+		f.Value += bottleneck
+	}
+}
+
+// Dinic - Compute the maximum flow with blocking flows on level graphs
+func (g *Graph[V, W]) Dinic(source, sink V) (*Flow[V, W], error) {
+	f, s, t, err := g.network(source, sink)
+	if err != nil || s == t {
+		return f, err
+	}
+
+	for {
+		level := f.levels(s, false)
+		if level[t] == -1 {
+			return f, nil
+		}
+
+		next := make([]int, len(f.vertices))
+		var augment func(v int, limit W) W
+		augment = func(v int, limit W) W {
+			if v == t {
+				return limit
+			}
+			for ; next[v] < len(f.adj[v]); next[v]++ {
+				id := f.adj[v][next[v]]
+				a := f.arcs[id]
+				r := f.residual(id)
+				if r <= 0 || level[a.to] != level[v]+1 {
+					continue
+				}
+				if r > limit {
+					r = limit
+				}
+				if pushed := augment(a.to, r); pushed > 0 {
+					f.push(id, pushed)
+					return pushed
+				}
+			}
+			return 0
+		}
+
+		for {
+			var unbounded W
+			for _, id := range f.adj[s] {
+				unbounded += f.residual(id)
+			}
+			pushed := augment(s, unbounded)
+			if pushed <= 0 {
+				break
+			}
+			f.Value += pushed
+		}
+	}
+}
+
+// FlowOn - Return the flow going from -> to
+func (f *Flow[V, W]) FlowOn(from, to V) W {
+	var total W
+	for v, ids := range f.adj {
+		if f.vertices[v] != from {
+			continue
+		}
+		for _, id := range ids {
+			if a := f.arcs[id]; f.vertices[a.to] == to {
+				total += a.flow
+			}
+		}
+	}
+	return total
+}
+
+// MinCut - Return the vertices on the source side of a minimum cut and the saturated edges crossing it
+func (f *Flow[V, W]) MinCut() (mtype.ComparableSet[V], []Edge[V, W]) {
+	var s int
+	for i, v := range f.vertices {
+		if v == f.Source {
+			s = i
+		}
+	}
+
+	reached := f.levels(s, false)
+	side := mtype.Comparable[V]()
+	for i, level := range reached {
+		if level != -1 {
+			side.Add(f.vertices[i])
+		}
+	}
+
+	cut := make([]Edge[V, W], 0)
+	for v, ids := range f.adj {
+		for _, id := range ids {
+			a := f.arcs[id]
+			if reached[v] != -1 && reached[a.to] == -1 && a.capacity > 0 {
+				cut = append(cut, Edge[V, W]{f.vertices[v], f.vertices[a.to], a.capacity})
+			}
+		}
+	}
+	return side, cut
+}
+
+// network - Build the residual network of the graph, undirected edges carry flow both ways
+func (g *Graph[V, W]) network(source, sink V) (*Flow[V, W], int, int, error) {
+	if !g.HasVertex(source) || !g.HasVertex(sink) {
+		return nil, 0, 0, errors.New(common.VertexNotExist)
+	}
+
+	f := &Flow[V, W]{Source: source, Sink: sink}
+	index := make(map[V]int, len(g.out))
+	for v := range g.out {
+		index[v] = len(f.vertices)
+		f.vertices = append(f.vertices, v)
+	}
+	f.adj = make([][]int, len(f.vertices))
+
+	for _, e := range g.Edges() {
+		if e.Weight < 0 {
+			return nil, 0, 0, errors.New(common.NegativeWeight)
+		}
+		back := W(0)
+		if !g.directed {
+			back = e.Weight
+		}
+		from, to := index[e.From], index[e.To]
+		f.adj[from] = append(f.adj[from], len(f.arcs))
+		f.arcs = append(f.arcs, arc[W]{to: to, capacity: e.Weight})
+		f.adj[to] = append(f.adj[to], len(f.arcs))
+		f.arcs = append(f.arcs, arc[W]{to: from, capacity: back})
+	}
+	return f, index[source], index[sink], nil
+}
+
+// residual - Return the room left on an arc, the flow of the reverse arc can be sent back
+func (f *Flow[V, W]) residual(id int) W {
+	return f.arcs[id].capacity - f.arcs[id].flow + f.arcs[id^1].flow
+}
+
+func (f *Flow[V, W]) push(id int, amount W) {
+	back := &f.arcs[id^1]
+	if back.flow >= amount {
+		back.flow -= amount
+		return
+	}
+	amount -= back.flow
+	back.flow = 0
+	f.arcs[id].flow += amount
+}
+
+// levels - Breadth-first search on the residual network from s, returning the level of
+// every vertex or, when parents is set, the arc used to reach it (-1 when unreachable)
+func (f *Flow[V, W]) levels(s int, parents bool) []int {
+	result := make([]int, len(f.vertices))
+	for i := range result {
+		result[i] = -1
+	}
+	level := make([]int, len(f.vertices))
+	level[s] = 0
+	if !parents {
+		result[s] = 0
+	}
+
+	seen := make([]bool, len(f.vertices))
+	seen[s] = true
+	queue := []int{s}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		for _, id := range f.adj[v] {
+			u := f.arcs[id].to
+			if seen[u] || f.residual(id) <= 0 {
+				continue
+			}
+			seen[u] = true
+			level[u] = level[v] + 1
+			if parents {
+				result[u] = id
+			} else {
+				result[u] = level[u]
+			}
+			queue = append(queue, u)
+		}
+	}
+	return result
+}
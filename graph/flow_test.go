@@ -0,0 +1,52 @@
+package graph
+
+import "testing"
+
+func TestFlowOnUnsignedWeights(t *testing.T) {
+	g := NewDirected[string, uint]()
+	g.AddEdge("s", "a", 3)
+	g.AddEdge("a", "t", 3)
+
+	for name, solve := range map[string]func(s, t string) (*Flow[string, uint], error){
+		"EdmondsKarp": g.EdmondsKarp,
+		"Dinic":       g.Dinic,
+	} {
+		t.Run(name, func(t *testing.T) {
+			f, err := solve("s", "t")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if f.Value != 3 {
+				t.Fatalf("value = %d, want 3", f.Value)
+			}
+			if got := f.FlowOn("s", "a"); got != 3 {
+				t.Fatalf("FlowOn(s, a) = %d, want 3", got)
+			}
+			// The reverse arc used to report the wrapped negative flow
+			if got := f.FlowOn("a", "s"); got != 0 {
+				t.Fatalf("FlowOn(a, s) = %d, want 0", got)
+			}
+		})
+	}
+}
+
+func TestFlowOnUndirected(t *testing.T) {
+	g := NewUndirected[string, uint]()
+	g.AddEdge("t", "a", 2)
+	g.AddEdge("s", "a", 5)
+
+	f, err := g.Dinic("s", "t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Value != 2 {
+		t.Fatalf("value = %d, want 2", f.Value)
+	}
+	// Flow runs against the direction the edge was added in
+	if got := f.FlowOn("a", "t"); got != 2 {
+		t.Fatalf("FlowOn(a, t) = %d, want 2", got)
+	}
+	if got := f.FlowOn("t", "a"); got != 0 {
+		t.Fatalf("FlowOn(t, a) = %d, want 0", got)
+	}
+}
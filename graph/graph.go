@@ -0,0 +1,223 @@
+package graph
+
+import (
+	"errors"
+
+	"github.com/rojack96/treje/common"
+	mtype "github.com/rojack96/treje/mapset/types"
+)
+
+// Edge - Weighted edge between two vertices
+type Edge[V comparable, W common.Number] struct {
+	From   V
+	To     V
+	Weight W
+}
+
+// Graph - Directed or undirected weighted graph stored as adjacency lists
+type Graph[V comparable, W common.Number] struct {
+	directed bool
+	out      map[V]map[V]W
+	in       map[V]map[V]W
+	edges    int
+}
+
+// NewDirected - Create a new empty directed graph
+func NewDirected[V comparable, W common.Number]() *Graph[V, W] {
+	return newGraph[V, W](true)
+}
+
+// NewUndirected - Create a new empty undirected graph
+func NewUndirected[V comparable, W common.Number]() *Graph[V, W] {
+	return newGraph[V, W](false)
+}
+
+func newGraph[V comparable, W common.Number](directed bool) *Graph[V, W] {
+	g := &Graph[V, W]{directed: directed, out: map[V]map[V]W{}}
+	g.in = g.out
+	if directed {
+		g.in = map[V]map[V]W{}
+	}
+	return g
+}
+
+/*
+	Manipulation graph methods
+*/
+
+// AddVertex - Add a vertex, return false if it was already present
+func (g *Graph[V, W]) AddVertex(v V) bool {
+	if g.HasVertex(v) {
+		return false
+	}
+	g.out[v] = map[V]W{}
+	if g.directed {
+		g.in[v] = map[V]W{}
+	}
+	return true
+}
+
+// RemoveVertex - Remove a vertex with all its edges, if the vertex not exists raise an error
+func (g *Graph[V, W]) RemoveVertex(v V) error {
+	if !g.HasVertex(v) {
+		return errors.New(common.VertexNotExist)
+	}
+
+	for to := range g.out[v] {
+		delete(g.in[to], v)
+		g.edges--
+	}
+	if g.directed {
+		for from := range g.in[v] {
+			if from != v {
+				delete(g.out[from], v)
+				g.edges--
+			}
+		}
+		delete(g.in, v)
+	}
+	delete(g.out, v)
+	return nil
+}
+
+// AddEdge - Add an edge or replace its weight, missing vertices are added
+func (g *Graph[V, W]) AddEdge(from, to V, weight W) {
+	g.AddVertex(from)
+	g.AddVertex(to)
+
+	if _, ok := g.out[from][to]; !ok {
+		g.edges++
+	}
+	g.out[from][to] = weight
+	g.in[to][from] = weight
+}
+
+// RemoveEdge - Remove an edge, if the edge not exists raise an error
+func (g *Graph[V, W]) RemoveEdge(from, to V) error {
+	if !g.HasEdge(from, to) {
+		return errors.New(common.EdgeNotExist)
+	}
+	delete(g.out[from], to)
+	delete(g.in[to], from)
+	g.edges--
+	return nil
+}
+
+// Clear - Remove all vertices and edges
+func (g *Graph[V, W]) Clear() {
+	*g = *newGraph[V, W](g.directed)
+}
+
+/*
+	Utility methods
+*/
+
+// Directed - Return true if the graph is directed
+func (g *Graph[V, W]) Directed() bool {
+	return g.directed
+}
+
+// HasVertex - Return true if the vertex is in the graph, otherwise false
+func (g *Graph[V, W]) HasVertex(v V) bool {
+	_, ok := g.out[v]
+	return ok
+}
+
+// HasEdge - Return true if there is an edge from -> to, otherwise false
+func (g *Graph[V, W]) HasEdge(from, to V) bool {
+	_, ok := g.out[from][to]
+	return ok
+}
+
+// Weight - Return the weight of the edge from -> to and true if the edge exists
+func (g *Graph[V, W]) Weight(from, to V) (W, bool) {
+	w, ok := g.out[from][to]
+	return w, ok
+}
+
+// Order - Return the number of vertices
+func (g *Graph[V, W]) Order() int {
+	return len(g.out)
+}
+
+// Size - Return the number of edges
+func (g *Graph[V, W]) Size() int {
+	return g.edges
+}
+
+// IsEmpty - Return true if the graph has no vertices, else false
+func (g *Graph[V, W]) IsEmpty() bool {
+	return len(g.out) == 0
+}
+
+// Vertices - Return all the vertices as a set
+func (g *Graph[V, W]) Vertices() mtype.ComparableSet[V] {
+	result := make(mtype.ComparableSet[V], len(g.out))
+	for v := range g.out {
+		result.Add(v)
+	}
+	return result
+}
+
+// Edges - Return all the edges, undirected edges are reported once
+func (g *Graph[V, W]) Edges() []Edge[V, W] {
+	result := make([]Edge[V, W], 0, g.edges)
+	seen := map[V]bool{}
+	for from, targets := range g.out {
+		for to, w := range targets {
+			if !g.directed && seen[to] {
+				continue
+			}
+			result = append(result, Edge[V, W]{from, to, w})
+		}
+		seen[from] = true
+	}
+	return result
+}
+
+// Neighbors - Return the vertices reachable from v through one edge
+func (g *Graph[V, W]) Neighbors(v V) (mtype.ComparableSet[V], error) {
+	return g.adjacent(g.out, v)
+}
+
+// Predecessors - Return the vertices with an edge towards v, the neighbors for undirected graphs
+func (g *Graph[V, W]) Predecessors(v V) (mtype.ComparableSet[V], error) {
+	return g.adjacent(g.in, v)
+}
+
+// OutDegree - Return the number of edges leaving v
+func (g *Graph[V, W]) OutDegree(v V) int {
+	return len(g.out[v])
+}
+
+// InDegree - Return the number of edges reaching v
+func (g *Graph[V, W]) InDegree(v V) int {
+	return len(g.in[v])
+}
+
+// Copy - Return a deep copy of the graph
+func (g *Graph[V, W]) Copy() *Graph[V, W] {
+	c := newGraph[V, W](g.directed)
+	for v := range g.out {
+		c.AddVertex(v)
+	}
+	for from, targets := range g.out {
+		for to, w := range targets {
+			c.AddEdge(from, to, w)
+		}
+	}
+	return c
+}
+
+func (g *Graph[V, W]) adjacent(lists map[V]map[V]W, v V) (mtype.ComparableSet[V], error) {
+	targets, ok := lists[v]
+	if !ok {
+		return nil, errors.New(common.VertexNotExist)
+	}
+
+	result := make(mtype.ComparableSet[V], len(targets))
+	for u := range targets {
+		result.Add(u)
+	}
+	return result, nil
+}
@@ -0,0 +1,93 @@
+package graph
+
+import (
+	"errors"
+
+	"github.com/rojack96/treje/common"
+	mtype "github.com/rojack96/treje/mapset/types"
+)
+
+// HopcroftKarp - Return the edges of a maximum matching between the left vertices and their neighbors,
+// each going from a left vertex to its right partner.
+// Raise an error if an edge joins two left vertices.
+func (g *Graph[V, W]) HopcroftKarp(left mtype.ComparableSet[V]) ([]Edge[V, W], error) {
+	for u := range left {
+		if !g.HasVertex(u) {
+			return nil, errors.New(common.VertexNotExist)
+		}
+		for v := range g.out[u] {
+			if left.Has(v) {
+				return nil, errors.New(common.NotBipartite)
+			}
+		}
+	}
+
+	const unreached = -1
+	var (
+		pairLeft  = map[V]V{}
+		pairRight = map[V]V{}
+		dist      = map[V]int{}
+		free      int
+	)
+
+	bfs := func() bool {
+		queue := make([]V, 0, len(left))
+		for u := range left {
+			if _, matched := pairLeft[u]; matched {
+				dist[u] = unreached
+			} else {
+				dist[u] = 0
+				queue = append(queue, u)
+			}
+		}
+
+		free = unreached
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			if free != unreached && dist[u] >= free {
+				continue
+			}
+			for v := range g.out[u] {
+				w, matched := pairRight[v]
+				if !matched {
+					if free == unreached {
+						free = dist[u] + 1
+					}
+				} else if dist[w] == unreached {
+					dist[w] = dist[u] + 1
+					queue = append(queue, w)
+				}
+			}
+		}
+		return free != unreached
+	}
+
+	var dfs func(u V) bool
+	dfs = func(u V) bool {
+		for v := range g.out[u] {
+			w, matched := pairRight[v]
+			if (!matched && free == dist[u]+1) || (matched && dist[w] == dist[u]+1 && dfs(w)) {
+				pairLeft[u] = v
+				pairRight[v] = u
+				return true
+			}
+		}
+		dist[u] = unreached
+		return false
+	}
+
+	for bfs() {
+		for u := range left {
+			if _, matched := pairLeft[u]; !matched {
+				dfs(u)
+			}
+		}
+	}
+
+	matching := make([]Edge[V, W], 0, len(pairLeft))
+	for u, v := range pairLeft {
+		matching = append(matching, Edge[V, W]{u, v, g.out[u][v]})
+	}
+	return matching, nil
+}
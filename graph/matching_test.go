@@ -0,0 +1,42 @@
+package graph
+
+import (
+	"testing"
+
+	mtype "github.com/rojack96/treje/mapset/types"
+)
+
+func TestHopcroftKarp(t *testing.T) {
+	g := NewUndirected[string, int]()
+	g.AddEdge("a", "x", 1)
+	g.AddEdge("a", "y", 2)
+	g.AddEdge("b", "x", 3)
+	g.AddEdge("c", "x", 4)
+
+	left := mtype.Comparable("a", "b", "c")
+	matching, err := g.HopcroftKarp(left)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matching) != 2 {
+		t.Fatalf("matching = %v, want 2 edges", matching)
+	}
+	right := mtype.Comparable[string]()
+	for _, e := range matching {
+		if !left.Has(e.From) || right.Has(e.To) {
+			t.Fatalf("edge %v is not part of a matching %v", e, matching)
+		}
+		if w, ok := g.Weight(e.From, e.To); !ok || w != e.Weight {
+			t.Fatalf("edge %v is not in the graph", e)
+		}
+		right.Add(e.To)
+	}
+}
+
+func TestHopcroftKarpNotBipartite(t *testing.T) {
+	g := NewDirected[string, int]()
+	g.AddEdge("a", "b", 1)
+	if _, err := g.HopcroftKarp(mtype.Comparable("a", "b")); err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -0,0 +1,177 @@
+package graph
+
+import (
+	"container/heap"
+	"errors"
+
+	"github.com/rojack96/treje/common"
+)
+
+// ShortestPaths - Single-source shortest path tree
+type ShortestPaths[V comparable, W common.Number] struct {
+	Source V
+	Dist   map[V]W
+	Prev   map[V]V
+}
+
+// DistanceTo - Return the distance from the source and true if the vertex is reachable
+func (sp *ShortestPaths[V, W]) DistanceTo(v V) (W, bool) {
+	d, ok := sp.Dist[v]
+	return d, ok
+}
+
+// PathTo - Return the vertices from the source to v included, nil if v is unreachable
+func (sp *ShortestPaths[V, W]) PathTo(v V) []V {
+	if _, ok := sp.Dist[v]; !ok {
+		return nil
+	}
+
+	path := []V{v}
+	for v != sp.Source {
+		v = sp.Prev[v]
+		path = append(path, v)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// Dijkstra - Compute the shortest paths from source, raise an error on negative weights
+func (g *Graph[V, W]) Dijkstra(source V) (*ShortestPaths[V, W], error) {
+	if !g.HasVertex(source) {
+		return nil, errors.New(common.VertexNotExist)
+	}
+	for _, targets := range g.out {
+		for _, w := range targets {
+			if w < 0 {
+				return nil, errors.New(common.NegativeWeight)
+			}
+		}
+	}
+
+	sp := &ShortestPaths[V, W]{Source: source, Dist: map[V]W{source: 0}, Prev: map[V]V{}}
+	done := map[V]bool{}
+	pq := &priorityQueue[V, W]{}
+	heap.Push(pq, pqItem[V, W]{source, 0})
+
+	for pq.Len() > 0 {
+		v := heap.Pop(pq).(pqItem[V, W]).value
+		if done[v] {
+			continue
+		}
+		done[v] = true
+
+		for u, w := range g.out[v] {
+			d := sp.Dist[v] + w
+			if old, ok := sp.Dist[u]; !ok || d < old {
+				sp.Dist[u] = d
+				sp.Prev[u] = v
+				heap.Push(pq, pqItem[V, W]{u, d})
+			}
+		}
+	}
+	return sp, nil
+}
+
+// BellmanFord - Compute the shortest paths from source accepting negative weights,
+// raise an error if a negative cycle is reachable from source.
+// In an undirected graph every negative edge is a negative cycle.
+func (g *Graph[V, W]) BellmanFord(source V) (*ShortestPaths[V, W], error) {
+	if !g.HasVertex(source) {
+		return nil, errors.New(common.VertexNotExist)
+	}
+
+	sp := &ShortestPaths[V, W]{Source: source, Dist: map[V]W{source: 0}, Prev: map[V]V{}}
+	relax := func() bool {
+		changed := false
+		for v, targets := range g.out {
+			dv, ok := sp.Dist[v]
+			if !ok {
+				continue
+			}
+			for u, w := range targets {
+				if old, ok := sp.Dist[u]; !ok || dv+w < old {
+					sp.Dist[u] = dv + w
+					sp.Prev[u] = v
+					changed = true
+				}
+			}
+		}
+		return changed
+	}
+
+	for i := 1; i < len(g.out); i++ {
+		if !relax() {
+			return sp, nil
+		}
+	}
+	if relax() {
+		return nil, errors.New(common.NegativeCycle)
+	}
+	return sp, nil
+}
+
+// AStar - Return the shortest path from source to target guided by a heuristic estimating
+// the remaining distance to target. The heuristic must never overestimate for the path to be optimal.
+func (g *Graph[V, W]) AStar(source, target V, heuristic func(v V) W) ([]V, W, error) {
+	if !g.HasVertex(source) || !g.HasVertex(target) {
+		return nil, 0, errors.New(common.VertexNotExist)
+	}
+
+	sp := &ShortestPaths[V, W]{Source: source, Dist: map[V]W{source: 0}, Prev: map[V]V{}}
+	pq := &priorityQueue[V, W]{}
+	heap.Push(pq, pqItem[V, W]{source, heuristic(source)})
+
+	for pq.Len() > 0 {
+		item := heap.Pop(pq).(pqItem[V, W])
+		v := item.value
+		// Entries pushed before the distance of v improved are stale. There is no closed set:
+		// an admissible heuristic that is not consistent may improve an expanded vertex, which is expanded again.
+		if item.priority != sp.Dist[v]+heuristic(v) {
+			continue
+		}
+		if v == target {
+			return sp.PathTo(target), sp.Dist[target], nil
+		}
+
+		for u, w := range g.out[v] {
+			if w < 0 {
+				return nil, 0, errors.New(common.NegativeWeight)
+			}
+			d := sp.Dist[v] + w
+			if old, ok := sp.Dist[u]; !ok || d < old {
+				sp.Dist[u] = d
+				sp.Prev[u] = v
+				heap.Push(pq, pqItem[V, W]{u, d + heuristic(u)})
+			}
+		}
+	}
+	return nil, 0, errors.New(common.PathNotExist)
+}
+
+/*
+	Priority queue shared by the weighted algorithms
+*/
+
+type pqItem[V any, W common.Number] struct {
+	value    V
+	priority W
+}
+
+type priorityQueue[V any, W common.Number] []pqItem[V, W]
+
+func (pq priorityQueue[V, W]) Len() int           { return len(pq) }
+func (pq priorityQueue[V, W]) Less(i, j int) bool { return pq[i].priority < pq[j].priority }
+func (pq priorityQueue[V, W]) Swap(i, j int)      { pq[i], pq[j] = pq[j], pq[i] }
+
+func (pq *priorityQueue[V, W]) Push(x any) {
+	*pq = append(*pq, x.(pqItem[V, W]))
+}
+
+func (pq *priorityQueue[V, W]) Pop() any {
+	old := *pq
+	item := old[len(old)-1]
+	*pq = old[:len(old)-1]
+	return item
+}
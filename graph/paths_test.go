@@ -0,0 +1,25 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAStarReopensImprovedVertex(t *testing.T) {
+	g := NewDirected[string, int]()
+	g.AddEdge("s", "a", 1)
+	g.AddEdge("s", "b", 4)
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("b", "c", 1)
+	g.AddEdge("c", "t", 5)
+
+	// Admissible but inconsistent: b is reached through s first, then improved through a
+	h := map[string]int{"s": 7, "a": 6, "b": 0, "c": 5, "t": 0}
+	path, cost, err := g.AStar("s", "t", func(v string) int { return h[v] })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"s", "a", "b", "c", "t"}; !reflect.DeepEqual(path, want) || cost != 8 {
+		t.Fatalf("path = %v cost %d, want %v cost 8", path, cost, want)
+	}
+}
@@ -0,0 +1,75 @@
+package graph
+
+import (
+	"container/heap"
+	"errors"
+	"sort"
+
+	"github.com/rojack96/treje/common"
+	"github.com/rojack96/treje/disjointset"
+)
+
+// Kruskal - Return the edges of a minimum spanning forest and its total weight
+func (g *Graph[V, W]) Kruskal() ([]Edge[V, W], W, error) {
+	if g.directed {
+		return nil, 0, errors.New(common.IsDirected)
+	}
+
+	edges := g.Edges()
+	sort.Slice(edges, func(i, j int) bool {
+		return edges[i].Weight < edges[j].Weight
+	})
+
+	var total W
+	forest := disjointset.New[V]()
+	for v := range g.out {
+		forest.Add(v)
+	}
+
+	result := make([]Edge[V, W], 0, len(g.out))
+	for _, e := range edges {
+		if merged, _ := forest.Union(e.From, e.To); !merged {
+			continue
+		}
+		result = append(result, e)
+		total += e.Weight
+	}
+	return result, total, nil
+}
+
+// Prim - Return the edges of a minimum spanning forest and its total weight
+func (g *Graph[V, W]) Prim() ([]Edge[V, W], W, error) {
+	if g.directed {
+		return nil, 0, errors.New(common.IsDirected)
+	}
+
+	var total W
+	result := make([]Edge[V, W], 0, len(g.out))
+	inTree := map[V]bool{}
+
+	for root := range g.out {
+		if inTree[root] {
+			continue
+		}
+
+		pq := &priorityQueue[Edge[V, W], W]{}
+		heap.Push(pq, pqItem[Edge[V, W], W]{Edge[V, W]{To: root}, 0})
+		for pq.Len() > 0 {
+			e := heap.Pop(pq).(pqItem[Edge[V, W], W]).value
+			if inTree[e.To] {
+				continue
+			}
+			inTree[e.To] = true
+			if e.To != root {
+				result = append(result, e)
+				total += e.Weight
+			}
+			for u, w := range g.out[e.To] {
+				if !inTree[u] {
+					heap.Push(pq, pqItem[Edge[V, W], W]{Edge[V, W]{e.To, u, w}, w})
+				}
+			}
+		}
+	}
+	return result, total, nil
+}
@@ -0,0 +1,149 @@
+package graph
+
+import (
+	"errors"
+
+	"github.com/rojack96/treje/common"
+	mtype "github.com/rojack96/treje/mapset/types"
+)
+
+/*
+	Traversal methods
+
+	Every traversal calls fn once per reached vertex and stops as soon as fn returns false.
+*/
+
+// BFS - Visit the vertices reachable from start in breadth-first order with their distance in edges
+func (g *Graph[V, W]) BFS(start V, fn func(v V, depth int) bool) error {
+	if !g.HasVertex(start) {
+		return errors.New(common.VertexNotExist)
+	}
+
+	depth := map[V]int{start: 0}
+	queue := []V{start}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		if !fn(v, depth[v]) {
+			return nil
+		}
+		for u := range g.out[v] {
+			if _, seen := depth[u]; !seen {
+				depth[u] = depth[v] + 1
+				queue = append(queue, u)
+			}
+		}
+	}
+	return nil
+}
+
+// DFS - Visit the vertices reachable from start in depth-first pre-order
+func (g *Graph[V, W]) DFS(start V, fn func(v V) bool) error {
+	if !g.HasVertex(start) {
+		return errors.New(common.VertexNotExist)
+	}
+
+	seen := map[V]bool{}
+	stack := []V{start}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if seen[v] {
+			continue
+		}
+		seen[v] = true
+		if !fn(v) {
+			return nil
+		}
+		for u := range g.out[v] {
+			if !seen[u] {
+				stack = append(stack, u)
+			}
+		}
+	}
+	return nil
+}
+
+// TopologicalSort - Return the vertices of a directed graph so that every edge points forward,
+// raise an error if the graph has a cycle
+func (g *Graph[V, W]) TopologicalSort() ([]V, error) {
+	if !g.directed {
+		return nil, errors.New(common.NotDirected)
+	}
+
+	indegree := make(map[V]int, len(g.out))
+	queue := make([]V, 0)
+	for v := range g.out {
+		indegree[v] = len(g.in[v])
+		if indegree[v] == 0 {
+			queue = append(queue, v)
+		}
+	}
+
+	result := make([]V, 0, len(g.out))
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		result = append(result, v)
+		for u := range g.out[v] {
+			indegree[u]--
+			if indegree[u] == 0 {
+				queue = append(queue, u)
+			}
+		}
+	}
+
+	if len(result) != len(g.out) {
+		return nil, errors.New(common.HasCycle)
+	}
+	return result, nil
+}
+
+// HasCycle - Return true if the graph contains a cycle
+func (g *Graph[V, W]) HasCycle() bool {
+	if g.directed {
+		_, err := g.TopologicalSort()
+		return err != nil
+	}
+
+	// An undirected forest has exactly one edge less than vertices per component
+	loops := 0
+	for v, targets := range g.out {
+		if _, ok := targets[v]; ok {
+			loops++
+		}
+	}
+	return loops > 0 || g.edges > len(g.out)-len(g.ConnectedComponents())
+}
+
+// ConnectedComponents - Return the connected components, edge direction is ignored
+// so directed graphs report their weakly connected components
+func (g *Graph[V, W]) ConnectedComponents() []mtype.ComparableSet[V] {
+	result := make([]mtype.ComparableSet[V], 0)
+	seen := map[V]bool{}
+
+	for start := range g.out {
+		if seen[start] {
+			continue
+		}
+
+		component := mtype.Comparable[V]()
+		stack := []V{start}
+		seen[start] = true
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			component.Add(v)
+			for _, lists := range []map[V]map[V]W{g.out, g.in} {
+				for u := range lists[v] {
+					if !seen[u] {
+						seen[u] = true
+						stack = append(stack, u)
+					}
+				}
+			}
+		}
+		result = append(result, component)
+	}
+	return result
+}
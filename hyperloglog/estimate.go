@@ -0,0 +1,160 @@
+package hyperloglog
+
+import (
+	"errors"
+	"math/bits"
+
+	"github.com/rojack96/treje/common"
+)
+
+// FromMapSet - Create a sketch recording the elements of a map set
+func FromMapSet[T common.Ordered, S ~map[T]V, V any](set S, precision uint8) (*HyperLogLog[T], error) {
+	h, err := New[T](precision)
+	if err != nil {
+		return nil, err
+	}
+	for elem := range set {
+		h.Add(elem)
+	}
+	return h, nil
+}
+
+// FromSlice - Create a sketch recording the elements of a slice based set
+func FromSlice[T common.Ordered, S ~[]T](set S, precision uint8) (*HyperLogLog[T], error) {
+	h, err := New[T](precision)
+	if err != nil {
+		return nil, err
+	}
+	for _, elem := range set {
+		h.Add(elem)
+	}
+	return h, nil
+}
+
+// EstimateUnion - Return the estimated cardinality of the union of map sets without building it
+func EstimateUnion[T common.Ordered, S ~map[T]V, V any](precision uint8, sets ...S) (uint64, error) {
+	sketches, err := mapSketches(precision, sets)
+	if err != nil {
+		return 0, err
+	}
+	return unionAll(sketches), nil
+}
+
+// EstimateIntersection - Return the estimated cardinality of the intersection of map sets
+// by inclusion-exclusion over their unions, the error grows with the number of sets.
+// Every subset of the sets is visited, so at most 16 sets are accepted.
+func EstimateIntersection[T common.Ordered, S ~map[T]V, V any](precision uint8, sets ...S) (uint64, error) {
+	if len(sets) > maxIntersected {
+		return 0, errors.New(common.TooManySets)
+	}
+	sketches, err := mapSketches(precision, sets)
+	if err != nil {
+		return 0, err
+	}
+	return intersection(sketches), nil
+}
+
+// EstimateUnionSlices - Return the estimated cardinality of the union of slice based sets
+func EstimateUnionSlices[T common.Ordered, S ~[]T](precision uint8, sets ...S) (uint64, error) {
+	sketches, err := sliceSketches(precision, sets)
+	if err != nil {
+		return 0, err
+	}
+	return unionAll(sketches), nil
+}
+
+// EstimateIntersectionSlices - Return the estimated cardinality of the intersection of slice based sets,
+// at most 16 sets as for EstimateIntersection
+func EstimateIntersectionSlices[T common.Ordered, S ~[]T](precision uint8, sets ...S) (uint64, error) {
+	if len(sets) > maxIntersected {
+		return 0, errors.New(common.TooManySets)
+	}
+	sketches, err := sliceSketches(precision, sets)
+	if err != nil {
+		return 0, err
+	}
+	return intersection(sketches), nil
+}
+
+/*
+	Internal helpers
+*/
+
+// maxIntersected - Inclusion-exclusion visits every subset of the sets
+const maxIntersected = 16
+
+func mapSketches[T common.Ordered, S ~map[T]V, V any](precision uint8, sets []S) ([]*HyperLogLog[T], error) {
+	sketches := make([]*HyperLogLog[T], len(sets))
+	for i, set := range sets {
+		h, err := FromMapSet(set, precision)
+		if err != nil {
+			return nil, err
+		}
+		sketches[i] = h
+	}
+	return sketches, nil
+}
+
+func sliceSketches[T common.Ordered, S ~[]T](precision uint8, sets []S) ([]*HyperLogLog[T], error) {
+	sketches := make([]*HyperLogLog[T], len(sets))
+	for i, set := range sets {
+		h, err := FromSlice(set, precision)
+		if err != nil {
+			return nil, err
+		}
+		sketches[i] = h
+	}
+	return sketches, nil
+}
+
+// unionAll - Return the estimated cardinality of the union of all the sketches
+func unionAll[T any](sketches []*HyperLogLog[T]) uint64 {
+	if len(sketches) == 0 {
+		return 0
+	}
+	merged := sketches[0].Copy()
+	for _, h := range sketches[1:] {
+		_ = merged.Merge(h)
+	}
+	return merged.Count()
+}
+
+// union - Return the estimated cardinality of the union of the sketches selected by mask
+func union[T any](sketches []*HyperLogLog[T], mask int) uint64 {
+	var merged *HyperLogLog[T]
+	for i, h := range sketches {
+		if mask&(1<<i) == 0 {
+			continue
+		}
+		if merged == nil {
+			merged = h.Copy()
+		} else {
+			_ = merged.Merge(h)
+		}
+	}
+	if merged == nil {
+		return 0
+	}
+	return merged.Count()
+}
+
+func intersection[T any](sketches []*HyperLogLog[T]) uint64 {
+	if len(sketches) == 0 {
+		return 0
+	}
+
+	total := 0.0
+	for mask := 1; mask < 1<<len(sketches); mask++ {
+		count := float64(union(sketches, mask))
+		// |A ∩ B ∩ ...| = Σ (-1)^(|subset|+1) |∪ subset|
+		if bits.OnesCount(uint(mask))%2 == 1 {
+			total += count
+		} else {
+			total -= count
+		}
+	}
+	if total < 0 {
+		return 0
+	}
+	return uint64(total + 0.5)
+}
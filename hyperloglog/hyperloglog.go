@@ -0,0 +1,264 @@
+package hyperloglog
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/bits"
+	"sort"
+
+	"github.com/rojack96/treje/common"
+)
+
+const (
+	// MinPrecision - Smallest precision, 16 registers
+	MinPrecision = 4
+	// MaxPrecision - Largest precision, 262144 registers
+	MaxPrecision = 18
+	// DefaultPrecision - 16384 registers, standard error around 0.8%
+	DefaultPrecision = 14
+
+	sparseFormat = 0
+	denseFormat  = 1
+)
+
+// HyperLogLog - Cardinality estimator using a fixed amount of memory.
+// Small sketches keep only the non-zero registers and switch to a dense array when it gets cheaper.
+type HyperLogLog[T any] struct {
+	precision uint8
+	sparse    map[uint32]uint8
+	dense     []uint8
+	hash      common.Hasher[T]
+}
+
+// New - Create a new sketch for ordered elements, precision between MinPrecision and MaxPrecision
+func New[T common.Ordered](precision uint8) (*HyperLogLog[T], error) {
+	return NewFunc(precision, common.Hash[T])
+}
+
+// NewFunc - Create a new sketch using a custom hash
+func NewFunc[T any](precision uint8, hash common.Hasher[T]) (*HyperLogLog[T], error) {
+	if precision < MinPrecision || precision > MaxPrecision {
+		return nil, errors.New(common.InvalidPrecision)
+	}
+	return &HyperLogLog[T]{precision: precision, sparse: map[uint32]uint8{}, hash: hash}, nil
+}
+
+/*
+	Manipulation methods
+*/
+
+// Add - Record an element
+func (h *HyperLogLog[T]) Add(elem T) {
+	x := common.Mix64(h.hash(elem))
+	index := uint32(x >> (64 - h.precision))
+	rank := uint8(bits.LeadingZeros64(x<<h.precision|1<<(h.precision-1))) + 1
+	h.set(index, rank)
+}
+
+// Merge - Add every element recorded by other, both sketches must share the precision
+func (h *HyperLogLog[T]) Merge(other *HyperLogLog[T]) error {
+	if h.precision != other.precision {
+		return errors.New(common.Incompatible)
+	}
+
+	if other.dense != nil {
+		for index, rank := range other.dense {
+			if rank > 0 {
+				h.set(uint32(index), rank)
+			}
+		}
+		return nil
+	}
+	for index, rank := range other.sparse {
+		h.set(index, rank)
+	}
+	return nil
+}
+
+// Clear - Forget every element
+func (h *HyperLogLog[T]) Clear() {
+	h.sparse = map[uint32]uint8{}
+	h.dense = nil
+}
+
+/*
+	Utility methods
+*/
+
+// Count - Return the estimated number of distinct elements
+func (h *HyperLogLog[T]) Count() uint64 {
+	m := float64(h.registers())
+
+	sum, zeros := 0.0, 0
+	if h.dense != nil {
+		for _, rank := range h.dense {
+			sum += math.Ldexp(1, -int(rank))
+			if rank == 0 {
+				zeros++
+			}
+		}
+	} else {
+		for _, rank := range h.sparse {
+			sum += math.Ldexp(1, -int(rank))
+		}
+		zeros = h.registers() - len(h.sparse)
+		sum += float64(zeros)
+	}
+
+	estimate := h.alpha() * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(estimate + 0.5)
+}
+
+// IsEmpty - Return true if no element was recorded, else false
+func (h *HyperLogLog[T]) IsEmpty() bool {
+	if h.dense == nil {
+		return len(h.sparse) == 0
+	}
+	for _, rank := range h.dense {
+		if rank > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Precision - Return the precision, the sketch has 2^precision registers
+func (h *HyperLogLog[T]) Precision() uint8 {
+	return h.precision
+}
+
+// IsSparse - Return true while the sketch stores only its non-zero registers
+func (h *HyperLogLog[T]) IsSparse() bool {
+	return h.dense == nil
+}
+
+// StandardError - Return the relative standard error of the estimations
+func (h *HyperLogLog[T]) StandardError() float64 {
+	return 1.04 / math.Sqrt(float64(h.registers()))
+}
+
+/*
+	Methods to manipulate a sketch object
+*/
+
+// Copy - Return a deep copy of the sketch
+func (h *HyperLogLog[T]) Copy() *HyperLogLog[T] {
+	c := &HyperLogLog[T]{precision: h.precision, hash: h.hash}
+	if h.dense != nil {
+		c.dense = make([]uint8, len(h.dense))
+		copy(c.dense, h.dense)
+		return c
+	}
+	c.sparse = make(map[uint32]uint8, len(h.sparse))
+	for index, rank := range h.sparse {
+		c.sparse[index] = rank
+	}
+	return c
+}
+
+// MarshalBinary - Encode the precision and the registers, sparse sketches stay compact.
+// Sparse registers are written by index, so equal sketches give equal bytes.
+func (h *HyperLogLog[T]) MarshalBinary() ([]byte, error) {
+	if h.dense != nil {
+		data := make([]byte, 2, 2+len(h.dense))
+		data[0], data[1] = h.precision, denseFormat
+		return append(data, h.dense...), nil
+	}
+
+	data := make([]byte, 2+5*len(h.sparse))
+	data[0], data[1] = h.precision, sparseFormat
+	indexes := make([]uint32, 0, len(h.sparse))
+	for index := range h.sparse {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+	for i, index := range indexes {
+		binary.BigEndian.PutUint32(data[2+5*i:], index)
+		data[2+5*i+4] = h.sparse[index]
+	}
+	return data, nil
+}
+
+// UnmarshalBinary - Replace the sketch with encoded data, the hash of the receiver is kept
+// and must be the one used by the encoded sketch
+func (h *HyperLogLog[T]) UnmarshalBinary(data []byte) error {
+	if h.hash == nil || len(data) < 2 || data[0] < MinPrecision || data[0] > MaxPrecision {
+		return errors.New(common.InvalidData)
+	}
+
+	precision, registers := data[0], 1<<data[0]
+	// Add never records more than the zeros left after the index bits, plus one
+	maxRank := 64 - precision + 1
+	payload := data[2:]
+	switch {
+	case data[1] == denseFormat && len(payload) == registers:
+		for _, rank := range payload {
+			if rank > maxRank {
+				return errors.New(common.InvalidData)
+			}
+		}
+		h.precision, h.sparse = precision, nil
+		h.dense = make([]uint8, registers)
+		copy(h.dense, payload)
+	case data[1] == sparseFormat && len(payload)%5 == 0:
+		sparse := make(map[uint32]uint8, len(payload)/5)
+		for i := 0; i < len(payload); i += 5 {
+			index, rank := binary.BigEndian.Uint32(payload[i:]), payload[i+4]
+			if index >= uint32(registers) || rank == 0 || rank > maxRank {
+				return errors.New(common.InvalidData)
+			}
+			sparse[index] = rank
+		}
+		h.precision, h.sparse, h.dense = precision, sparse, nil
+	default:
+		return errors.New(common.InvalidData)
+	}
+	return nil
+}
+
+/*
+	Internal helpers
+*/
+
+func (h *HyperLogLog[T]) registers() int {
+	return 1 << h.precision
+}
+
+func (h *HyperLogLog[T]) alpha() float64 {
+	switch m := h.registers(); m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	default:
+		return 0.7213 / (1 + 1.079/float64(m))
+	}
+}
+
+// set - Raise a register to rank, switching to the dense form when the sparse map gets too big
+func (h *HyperLogLog[T]) set(index uint32, rank uint8) {
+	if h.dense != nil {
+		if rank > h.dense[index] {
+			h.dense[index] = rank
+		}
+		return
+	}
+
+	if rank > h.sparse[index] {
+		h.sparse[index] = rank
+	}
+	// A map entry costs several bytes, past a quarter of the registers the array is smaller
+	if len(h.sparse) > h.registers()/4 {
+		h.dense = make([]uint8, h.registers())
+		for i, r := range h.sparse {
+			h.dense[i] = r
+		}
+		h.sparse = nil
+	}
+}
@@ -0,0 +1,81 @@
+package hyperloglog
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestMarshalBinaryDeterministic(t *testing.T) {
+	a, _ := New[int](DefaultPrecision)
+	b, _ := New[int](DefaultPrecision)
+	for i := 0; i < 50; i++ {
+		a.Add(i)
+		b.Add(49 - i)
+	}
+	if !a.IsSparse() {
+		t.Fatal("expected a sparse sketch")
+	}
+	first, _ := a.MarshalBinary()
+	for i := 0; i < 10; i++ {
+		data, _ := b.MarshalBinary()
+		if !bytes.Equal(first, data) {
+			t.Fatal("equal sketches encode differently")
+		}
+	}
+}
+
+func TestUnmarshalBinaryRejectsBadRanks(t *testing.T) {
+	const precision = MinPrecision
+	maxRank := byte(64 - precision + 1)
+	dense := func(rank byte) []byte {
+		data := make([]byte, 2+1<<precision)
+		data[0], data[1], data[2] = precision, denseFormat, rank
+		return data
+	}
+	sparse := func(rank byte) []byte {
+		return []byte{precision, sparseFormat, 0, 0, 0, 3, rank}
+	}
+
+	tests := []struct {
+		name  string
+		data  []byte
+		valid bool
+	}{
+		{"dense max rank", dense(maxRank), true},
+		{"dense rank too large", dense(maxRank + 1), false},
+		{"sparse max rank", sparse(maxRank), true},
+		{"sparse rank too large", sparse(255), false},
+		{"sparse zero rank", sparse(0), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, _ := New[int](DefaultPrecision)
+			err := h.UnmarshalBinary(tt.data)
+			if (err == nil) != tt.valid {
+				t.Fatalf("error = %v, valid = %v", err, tt.valid)
+			}
+		})
+	}
+}
+
+func TestEstimateManySets(t *testing.T) {
+	sets := make([][]int, maxIntersected+1)
+	for i := range sets {
+		sets[i] = []int{i, 1000}
+	}
+
+	count, err := EstimateUnionSlices(DefaultPrecision, sets...)
+	if err != nil {
+		t.Fatalf("union of %d sets: %v", len(sets), err)
+	}
+	if count < 15 || count > 20 {
+		t.Fatalf("union estimate = %d, want about %d", count, len(sets)+1)
+	}
+
+	if _, err := EstimateIntersectionSlices(DefaultPrecision, sets...); err == nil {
+		t.Fatal("expected an error for too many intersected sets")
+	}
+	if _, err := EstimateIntersectionSlices(DefaultPrecision, sets[:2]...); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,393 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/rojack96/treje/common"
+)
+
+// Range - Half-open range of integers [Lo, Hi)
+type Range[T common.Integer] struct {
+	Lo T
+	Hi T
+}
+
+// Len - Return the number of integers in the range
+func (r Range[T]) Len() uint64 {
+	if r.Hi <= r.Lo {
+		return 0
+	}
+	// The conversion wraps signed bounds consistently, so the difference stays exact
+	return uint64(r.Hi) - uint64(r.Lo)
+}
+
+// IsEmpty - Return true if the range holds no integer, else false
+func (r Range[T]) IsEmpty() bool {
+	return r.Hi <= r.Lo
+}
+
+// Has - Return true if the range holds the element, else false
+func (r Range[T]) Has(elem T) bool {
+	return r.Lo <= elem && elem < r.Hi
+}
+
+// IntervalSet - Set of integers stored as sorted, disjoint and non adjacent half-open ranges.
+// Being half-open, a range cannot hold the largest value of T.
+type IntervalSet[T common.Integer] struct {
+	ranges []Range[T]
+}
+
+// NewSet - Create a new set, optionally filled from ranges, empty or reversed ranges are ignored
+func NewSet[T common.Integer](ranges ...Range[T]) *IntervalSet[T] {
+	set := &IntervalSet[T]{}
+	for _, r := range ranges {
+		if !r.IsEmpty() {
+			set.insert(r.Lo, r.Hi)
+		}
+	}
+	return set
+}
+
+// FromSlice - Create a new set from the elements of a slice based set
+func FromSlice[T common.Integer, S ~[]T](elems S) (*IntervalSet[T], error) {
+	sorted := make([]T, len(elems))
+	copy(sorted, elems)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	set := &IntervalSet[T]{}
+	for _, elem := range sorted {
+		if elem+1 < elem {
+			return nil, errors.New(common.InvalidRange)
+		}
+		set.insert(elem, elem+1)
+	}
+	return set, nil
+}
+
+// FromMapSet - Create a new set from the elements of a map set
+func FromMapSet[T common.Integer, S ~map[T]V, V any](elems S) (*IntervalSet[T], error) {
+	slice := make([]T, 0, len(elems))
+	for elem := range elems {
+		slice = append(slice, elem)
+	}
+	return FromSlice(slice)
+}
+
+/*
+	Manipulation set methods
+*/
+
+// Add - Insert an element if and only if it is not already present,
+// the largest value of T cannot be stored
+func (set *IntervalSet[T]) Add(elem T) error {
+	if elem+1 < elem {
+		return errors.New(common.InvalidRange)
+	}
+	if set.Has(elem) {
+		return errors.New(fmt.Sprint(elem) + " " + common.AlreadyExists)
+	}
+	set.insert(elem, elem+1)
+	return nil
+}
+
+// AddRange - Insert every integer of [lo, hi), merging with the touching ranges
+func (set *IntervalSet[T]) AddRange(lo, hi T) error {
+	if lo > hi {
+		return errors.New(common.InvalidRange)
+	}
+	if lo < hi {
+		set.insert(lo, hi)
+	}
+	return nil
+}
+
+// Remove - Remove a specific element from a set, if the element not exists raise an error
+func (set *IntervalSet[T]) Remove(elem T) error {
+	if set.IsEmpty() {
+		return errors.New(common.EmptySet)
+	}
+	if !set.Has(elem) {
+		return errors.New(common.ElemNotExist)
+	}
+	set.cut(elem, elem+1)
+	return nil
+}
+
+// Discard - Remove a specific element from set
+func (set *IntervalSet[T]) Discard(elem T) {
+	if set.Has(elem) {
+		set.cut(elem, elem+1)
+	}
+}
+
+// RemoveRange - Remove every integer of [lo, hi), splitting the ranges crossing the bounds
+func (set *IntervalSet[T]) RemoveRange(lo, hi T) error {
+	if lo > hi {
+		return errors.New(common.InvalidRange)
+	}
+	if lo < hi {
+		set.cut(lo, hi)
+	}
+	return nil
+}
+
+/*
+	Set operation methods
+*/
+
+// Union - Returns a new set with the elements of both sets
+func (set *IntervalSet[T]) Union(b *IntervalSet[T]) *IntervalSet[T] {
+	return set.combine(b, func(inA, inB bool) bool { return inA || inB })
+}
+
+// Intersect - Returns a new set with the elements present in both sets
+func (set *IntervalSet[T]) Intersect(b *IntervalSet[T]) *IntervalSet[T] {
+	return set.combine(b, func(inA, inB bool) bool { return inA && inB })
+}
+
+// Difference - Returns a new set with the elements of the set not present in b
+func (set *IntervalSet[T]) Difference(b *IntervalSet[T]) *IntervalSet[T] {
+	return set.combine(b, func(inA, inB bool) bool { return inA && !inB })
+}
+
+// SymmetricDifference - Returns a new set with the elements present in only one of the sets
+func (set *IntervalSet[T]) SymmetricDifference(b *IntervalSet[T]) *IntervalSet[T] {
+	return set.combine(b, func(inA, inB bool) bool { return inA != inB })
+}
+
+// Complement - Returns a new set with the elements of [lo, hi) not present in the set
+func (set *IntervalSet[T]) Complement(lo, hi T) (*IntervalSet[T], error) {
+	if lo > hi {
+		return nil, errors.New(common.InvalidRange)
+	}
+	return NewSet(Range[T]{lo, hi}).Difference(set), nil
+}
+
+// IsSubsetOf - Returns true if every element of the set is in b
+func (set *IntervalSet[T]) IsSubsetOf(b *IntervalSet[T]) bool {
+	return set.Difference(b).IsEmpty()
+}
+
+// Equals - Returns true if both sets hold the same elements
+func (set *IntervalSet[T]) Equals(b *IntervalSet[T]) bool {
+	if len(set.ranges) != len(b.ranges) {
+		return false
+	}
+	for i, r := range set.ranges {
+		if b.ranges[i] != r {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+	Utility methods
+*/
+
+// Has - Return true if the element is in the set, else false
+func (set *IntervalSet[T]) Has(elem T) bool {
+	i := set.search(elem)
+	return i < len(set.ranges) && set.ranges[i].Lo <= elem
+}
+
+// HasRange - Return true if every integer of [lo, hi) is in the set, else false
+func (set *IntervalSet[T]) HasRange(lo, hi T) bool {
+	if lo >= hi {
+		return true
+	}
+	i := set.search(lo)
+	return i < len(set.ranges) && set.ranges[i].Lo <= lo && hi <= set.ranges[i].Hi
+}
+
+// RangeOf - Return the stored range holding the element, false if it is not in the set
+func (set *IntervalSet[T]) RangeOf(elem T) (Range[T], bool) {
+	if i := set.search(elem); i < len(set.ranges) && set.ranges[i].Lo <= elem {
+		return set.ranges[i], true
+	}
+	return Range[T]{}, false
+}
+
+// Len - Return the number of integers in the set
+func (set *IntervalSet[T]) Len() uint64 {
+	var total uint64
+	for _, r := range set.ranges {
+		total += r.Len()
+	}
+	return total
+}
+
+// Count - Return the number of stored ranges
+func (set *IntervalSet[T]) Count() int {
+	return len(set.ranges)
+}
+
+// IsEmpty - Return true if the set is empty, else false
+func (set *IntervalSet[T]) IsEmpty() bool {
+	return len(set.ranges) == 0
+}
+
+// Clear - Remove all elements
+func (set *IntervalSet[T]) Clear() {
+	set.ranges = nil
+}
+
+// Min - Return the smallest element, raise an error if the set is empty
+func (set *IntervalSet[T]) Min() (T, error) {
+	if set.IsEmpty() {
+		var zero T
+		return zero, errors.New(common.EmptySet)
+	}
+	return set.ranges[0].Lo, nil
+}
+
+// Max - Return the largest element, raise an error if the set is empty
+func (set *IntervalSet[T]) Max() (T, error) {
+	if set.IsEmpty() {
+		var zero T
+		return zero, errors.New(common.EmptySet)
+	}
+	return set.ranges[len(set.ranges)-1].Hi - 1, nil
+}
+
+// Ranges - Return a copy of the stored ranges in increasing order
+func (set *IntervalSet[T]) Ranges() []Range[T] {
+	ranges := make([]Range[T], len(set.ranges))
+	copy(ranges, set.ranges)
+	return ranges
+}
+
+// EachRange - Call fn on each stored range in increasing order until it returns false
+func (set *IntervalSet[T]) EachRange(fn func(r Range[T]) bool) {
+	for _, r := range set.ranges {
+		if !fn(r) {
+			return
+		}
+	}
+}
+
+// Each - Call fn on each element in increasing order until it returns false
+func (set *IntervalSet[T]) Each(fn func(elem T) bool) {
+	for _, r := range set.ranges {
+		for elem := r.Lo; elem < r.Hi; elem++ {
+			if !fn(elem) {
+				return
+			}
+		}
+	}
+}
+
+/*
+	Methods to manipulate a set object
+*/
+
+// Copy - Return a deep copy of the set
+func (set *IntervalSet[T]) Copy() (*IntervalSet[T], error) {
+	if set.IsEmpty() {
+		return nil, errors.New(common.CopyEmpty)
+	}
+	return &IntervalSet[T]{ranges: set.Ranges()}, nil
+}
+
+// ToSlice - Returns a sorted slice of every element, beware of the size of large ranges
+func (set *IntervalSet[T]) ToSlice() ([]T, error) {
+	if set.IsEmpty() {
+		return nil, errors.New(common.EmptySet)
+	}
+	elems := make([]T, 0, set.Len())
+	set.Each(func(elem T) bool {
+		elems = append(elems, elem)
+		return true
+	})
+	return elems, nil
+}
+
+/*
+	Internal helpers
+*/
+
+// search - Return the index of the first range ending after elem
+func (set *IntervalSet[T]) search(elem T) int {
+	return sort.Search(len(set.ranges), func(i int) bool { return set.ranges[i].Hi > elem })
+}
+
+// insert - Add [lo, hi) replacing the ranges it overlaps or touches
+func (set *IntervalSet[T]) insert(lo, hi T) {
+	i := sort.Search(len(set.ranges), func(i int) bool { return set.ranges[i].Hi >= lo })
+	j := sort.Search(len(set.ranges), func(j int) bool { return set.ranges[j].Lo > hi })
+	if i < j {
+		if set.ranges[i].Lo < lo {
+			lo = set.ranges[i].Lo
+		}
+		if set.ranges[j-1].Hi > hi {
+			hi = set.ranges[j-1].Hi
+		}
+	}
+	set.splice(i, j, Range[T]{lo, hi})
+}
+
+// cut - Remove [lo, hi) keeping the parts of the crossing ranges outside of it
+func (set *IntervalSet[T]) cut(lo, hi T) {
+	i := set.search(lo)
+	j := sort.Search(len(set.ranges), func(j int) bool { return set.ranges[j].Lo >= hi })
+	if i >= j {
+		return
+	}
+
+	kept := make([]Range[T], 0, 2)
+	if set.ranges[i].Lo < lo {
+		kept = append(kept, Range[T]{set.ranges[i].Lo, lo})
+	}
+	if set.ranges[j-1].Hi > hi {
+		kept = append(kept, Range[T]{hi, set.ranges[j-1].Hi})
+	}
+	set.splice(i, j, kept...)
+}
+
+// splice - Replace the ranges [i, j) with the given ones
+func (set *IntervalSet[T]) splice(i, j int, ranges ...Range[T]) {
+	tail := append(ranges, set.ranges[j:]...)
+	set.ranges = append(set.ranges[:i], tail...)
+}
+
+// combine - Sweep the bounds of both sets once, keeping the segments where keep holds
+func (set *IntervalSet[T]) combine(b *IntervalSet[T], keep func(inA, inB bool) bool) *IntervalSet[T] {
+	bounds := make([]T, 0, 2*(len(set.ranges)+len(b.ranges)))
+	for _, r := range set.ranges {
+		bounds = append(bounds, r.Lo, r.Hi)
+	}
+	for _, r := range b.ranges {
+		bounds = append(bounds, r.Lo, r.Hi)
+	}
+	sort.Slice(bounds, func(i, j int) bool { return bounds[i] < bounds[j] })
+
+	result := &IntervalSet[T]{}
+	i, j := 0, 0
+	for k := 0; k+1 < len(bounds); k++ {
+		lo, hi := bounds[k], bounds[k+1]
+		if lo == hi {
+			continue
+		}
+		for i < len(set.ranges) && set.ranges[i].Hi <= lo {
+			i++
+		}
+		for j < len(b.ranges) && b.ranges[j].Hi <= lo {
+			j++
+		}
+		inA := i < len(set.ranges) && set.ranges[i].Lo <= lo
+		inB := j < len(b.ranges) && b.ranges[j].Lo <= lo
+		if !keep(inA, inB) {
+			continue
+		}
+
+		// Segments come in increasing order, so extending the last range is enough to coalesce
+		if n := len(result.ranges); n > 0 && result.ranges[n-1].Hi == lo {
+			result.ranges[n-1].Hi = hi
+		} else {
+			result.ranges = append(result.ranges, Range[T]{lo, hi})
+		}
+	}
+	return result
+}
@@ -0,0 +1,329 @@
+package interval
+
+import (
+	"errors"
+
+	"github.com/rojack96/treje/common"
+)
+
+// Interval - Closed interval [Lo, Hi] carrying a value
+type Interval[T any, V any] struct {
+	Lo    T
+	Hi    T
+	Value V
+}
+
+// IntervalTree - AVL tree of closed intervals ordered by start then end,
+// each node keeping the largest end of its subtree to prune overlap queries.
+// Several intervals may share the same bounds.
+type IntervalTree[T any, V any] struct {
+	root    *node[T, V]
+	size    int
+	compare common.Comparator[T]
+}
+
+type node[T any, V any] struct {
+	interval    Interval[T, V]
+	max         T
+	height      int
+	left, right *node[T, V]
+}
+
+// NewTree - Create a new tree of intervals over an ordered type
+func NewTree[T common.Ordered, V any]() *IntervalTree[T, V] {
+	return NewTreeFunc[T, V](common.Compare[T])
+}
+
+// NewTreeFunc - Create a new tree of intervals ordered by the given comparator
+func NewTreeFunc[T any, V any](compare common.Comparator[T]) *IntervalTree[T, V] {
+	return &IntervalTree[T, V]{compare: compare}
+}
+
+/*
+	Manipulation methods
+*/
+
+// Insert - Add the interval [lo, hi] with its value, raise an error if lo is after hi
+func (t *IntervalTree[T, V]) Insert(lo, hi T, value V) error {
+	if t.compare(lo, hi) > 0 {
+		return errors.New(common.InvalidRange)
+	}
+	t.root = t.insert(t.root, Interval[T, V]{Lo: lo, Hi: hi, Value: value})
+	t.size++
+	return nil
+}
+
+// Delete - Remove one interval with the given bounds, return true if one was found
+func (t *IntervalTree[T, V]) Delete(lo, hi T) bool {
+	var deleted bool
+	t.root, deleted = t.delete(t.root, lo, hi)
+	if deleted {
+		t.size--
+	}
+	return deleted
+}
+
+// Clear - Remove all intervals
+func (t *IntervalTree[T, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+/*
+	Query methods
+*/
+
+// Overlapping - Return the intervals sharing at least one point with [lo, hi], ordered by start
+func (t *IntervalTree[T, V]) Overlapping(lo, hi T) []Interval[T, V] {
+	var result []Interval[T, V]
+	t.EachOverlapping(lo, hi, func(i Interval[T, V]) bool {
+		result = append(result, i)
+		return true
+	})
+	return result
+}
+
+// Stabbing - Return the intervals holding the point, ordered by start
+func (t *IntervalTree[T, V]) Stabbing(point T) []Interval[T, V] {
+	return t.Overlapping(point, point)
+}
+
+// Overlaps - Return true if at least one interval shares a point with [lo, hi], else false
+func (t *IntervalTree[T, V]) Overlaps(lo, hi T) bool {
+	found := false
+	t.EachOverlapping(lo, hi, func(Interval[T, V]) bool {
+		found = true
+		return false
+	})
+	return found
+}
+
+// EachOverlapping - Call fn on each interval sharing a point with [lo, hi], ordered by start,
+// until it returns false
+func (t *IntervalTree[T, V]) EachOverlapping(lo, hi T, fn func(i Interval[T, V]) bool) {
+	t.overlapping(t.root, lo, hi, fn)
+}
+
+/*
+	Utility methods
+*/
+
+// Len - Return the number of intervals
+func (t *IntervalTree[T, V]) Len() int {
+	return t.size
+}
+
+// IsEmpty - Return true if the tree is empty, else false
+func (t *IntervalTree[T, V]) IsEmpty() bool {
+	return t.root == nil
+}
+
+// Height - Return the height of the tree, zero when empty
+func (t *IntervalTree[T, V]) Height() int {
+	return height(t.root)
+}
+
+// Min - Return the interval starting first, false if the tree is empty
+func (t *IntervalTree[T, V]) Min() (Interval[T, V], bool) {
+	if t.root == nil {
+		return Interval[T, V]{}, false
+	}
+	n := t.root
+	for n.left != nil {
+		n = n.left
+	}
+	return n.interval, true
+}
+
+// Max - Return the interval starting last, false if the tree is empty
+func (t *IntervalTree[T, V]) Max() (Interval[T, V], bool) {
+	if t.root == nil {
+		return Interval[T, V]{}, false
+	}
+	n := t.root
+	for n.right != nil {
+		n = n.right
+	}
+	return n.interval, true
+}
+
+// Span - Return the smallest start and the largest end of the intervals, false if the tree is empty
+func (t *IntervalTree[T, V]) Span() (T, T, bool) {
+	first, ok := t.Min()
+	if !ok {
+		var zero T
+		return zero, zero, false
+	}
+	return first.Lo, t.root.max, true
+}
+
+// InOrder - Call fn on each interval ordered by start then end until it returns false
+func (t *IntervalTree[T, V]) InOrder(fn func(i Interval[T, V]) bool) {
+	inOrder(t.root, fn)
+}
+
+// Intervals - Return every interval ordered by start then end
+func (t *IntervalTree[T, V]) Intervals() []Interval[T, V] {
+	result := make([]Interval[T, V], 0, t.size)
+	t.InOrder(func(i Interval[T, V]) bool {
+		result = append(result, i)
+		return true
+	})
+	return result
+}
+
+// Copy - Return a copy of the tree, values are copied shallowly
+func (t *IntervalTree[T, V]) Copy() *IntervalTree[T, V] {
+	return &IntervalTree[T, V]{root: clone(t.root), size: t.size, compare: t.compare}
+}
+
+/*
+	Internal helpers
+*/
+
+// order - Compare two intervals by start then end
+func (t *IntervalTree[T, V]) order(lo1, hi1, lo2, hi2 T) int {
+	if c := t.compare(lo1, lo2); c != 0 {
+		return c
+	}
+	return t.compare(hi1, hi2)
+}
+
+func (t *IntervalTree[T, V]) insert(n *node[T, V], i Interval[T, V]) *node[T, V] {
+	if n == nil {
+		return &node[T, V]{interval: i, max: i.Hi, height: 1}
+	}
+	// Equal intervals go right, so they are visited in insertion order
+	if t.order(i.Lo, i.Hi, n.interval.Lo, n.interval.Hi) < 0 {
+		n.left = t.insert(n.left, i)
+	} else {
+		n.right = t.insert(n.right, i)
+	}
+	return t.balance(n)
+}
+
+func (t *IntervalTree[T, V]) delete(n *node[T, V], lo, hi T) (*node[T, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var deleted bool
+	switch c := t.order(lo, hi, n.interval.Lo, n.interval.Hi); {
+	case c < 0:
+		n.left, deleted = t.delete(n.left, lo, hi)
+	case c > 0:
+		n.right, deleted = t.delete(n.right, lo, hi)
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+		var successor *node[T, V]
+		n.right, successor = t.deleteMin(n.right)
+		n.interval, deleted = successor.interval, true
+	}
+	if !deleted {
+		return n, false
+	}
+	return t.balance(n), true
+}
+
+// deleteMin - Detach the first node of a subtree, return the new subtree and the detached node
+func (t *IntervalTree[T, V]) deleteMin(n *node[T, V]) (*node[T, V], *node[T, V]) {
+	if n.left == nil {
+		return n.right, n
+	}
+	var first *node[T, V]
+	n.left, first = t.deleteMin(n.left)
+	return t.balance(n), first
+}
+
+func (t *IntervalTree[T, V]) overlapping(n *node[T, V], lo, hi T, fn func(i Interval[T, V]) bool) bool {
+	// Nothing in the subtree ends at or after lo
+	if n == nil || t.compare(n.max, lo) < 0 {
+		return true
+	}
+	if !t.overlapping(n.left, lo, hi, fn) {
+		return false
+	}
+	// This node and its right subtree start after hi
+	if t.compare(n.interval.Lo, hi) > 0 {
+		return true
+	}
+	if t.compare(n.interval.Hi, lo) >= 0 && !fn(n.interval) {
+		return false
+	}
+	return t.overlapping(n.right, lo, hi, fn)
+}
+
+// update - Recompute the height and the largest end of a node from its children
+func (t *IntervalTree[T, V]) update(n *node[T, V]) {
+	n.height = 1 + height(n.left)
+	if h := height(n.right); h >= n.height {
+		n.height = h + 1
+	}
+	n.max = n.interval.Hi
+	for _, child := range []*node[T, V]{n.left, n.right} {
+		if child != nil && t.compare(child.max, n.max) > 0 {
+			n.max = child.max
+		}
+	}
+}
+
+func (t *IntervalTree[T, V]) balance(n *node[T, V]) *node[T, V] {
+	t.update(n)
+	switch factor := height(n.left) - height(n.right); {
+	case factor > 1:
+		if height(n.left.left) < height(n.left.right) {
+			n.left = t.rotateLeft(n.left)
+		}
+		return t.rotateRight(n)
+	case factor < -1:
+		if height(n.right.right) < height(n.right.left) {
+			n.right = t.rotateRight(n.right)
+		}
+		return t.rotateLeft(n)
+	}
+	return n
+}
+
+func (t *IntervalTree[T, V]) rotateLeft(n *node[T, V]) *node[T, V] {
+	r := n.right
+	n.right, r.left = r.left, n
+	t.update(n)
+	t.update(r)
+	return r
+}
+
+func (t *IntervalTree[T, V]) rotateRight(n *node[T, V]) *node[T, V] {
+	l := n.left
+	n.left, l.right = l.right, n
+	t.update(n)
+	t.update(l)
+	return l
+}
+
+func height[T any, V any](n *node[T, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func inOrder[T any, V any](n *node[T, V], fn func(i Interval[T, V]) bool) bool {
+	if n == nil {
+		return true
+	}
+	return inOrder(n.left, fn) && fn(n.interval) && inOrder(n.right, fn)
+}
+
+func clone[T any, V any](n *node[T, V]) *node[T, V] {
+	if n == nil {
+		return nil
+	}
+	c := *n
+	c.left, c.right = clone(n.left), clone(n.right)
+	return &c
+}
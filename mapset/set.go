@@ -5,3 +5,7 @@ import "github.com/rojack96/treje/mapset/types"
 func New() types.MapSet {
 	return types.MapSet{}
 }
+
+func NewComparable[T comparable](elems ...T) types.ComparableSet[T] {
+	return types.Comparable(elems...)
+}
@@ -0,0 +1,173 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rojack96/treje/common"
+)
+
+// ComparableSet - Map set of any comparable type, used when the element type is generic
+type ComparableSet[T comparable] map[T]void
+
+// Comparable - Create a new empty set or from a slice
+func Comparable[T comparable](elems ...T) ComparableSet[T] {
+	set := make(ComparableSet[T], len(elems))
+	for _, e := range elems {
+		set.Add(e)
+	}
+	return set
+}
+
+/*
+	Manipulation set methods
+*/
+
+// Add - Append a new element to the set if and only if it is not already present
+func (set *ComparableSet[T]) Add(elem T) {
+	(*set)[elem] = void{}
+}
+
+// Remove - Remove a specific element from a set, if the element not exists raise an error
+func (set *ComparableSet[T]) Remove(elem T) error {
+	if set.IsEmpty() {
+		return errors.New(common.EmptySet)
+	}
+
+	if !set.Has(elem) {
+		return errors.New(common.ElemNotExist)
+	}
+	set.Discard(elem)
+	return nil
+}
+
+// Discard - Remove a specific element from set
+func (set *ComparableSet[T]) Discard(elem T) {
+	delete(*set, elem)
+}
+
+/*
+	Set operation methods
+*/
+
+// Union - Merges the current set with another set, but returns an error
+// if there are any duplicates in the union.
+func (set *ComparableSet[T]) Union(b ComparableSet[T]) (ComparableSet[T], error) {
+
+	for elemB := range b {
+		if set.Has(elemB) {
+			return nil, errors.New(fmt.Sprint(elemB) + " " + common.AlreadyExists)
+		}
+		set.Add(elemB)
+	}
+	return *set, nil
+}
+
+// Intersect - Returns the elements that are present in both input sets.
+func (set *ComparableSet[T]) Intersect(b ComparableSet[T]) ComparableSet[T] {
+	result := make(ComparableSet[T])
+
+	for k := range *set {
+		if _, ok := b[k]; ok {
+			result[k] = void{}
+		}
+	}
+
+	return result
+}
+
+// Difference - Returns the elements that are present in the first set
+// but not in the second set.
+func (set *ComparableSet[T]) Difference(b ComparableSet[T]) ComparableSet[T] {
+	result := make(ComparableSet[T])
+
+	for k := range *set {
+		if _, ok := b[k]; !ok {
+			result[k] = void{}
+		}
+	}
+
+	return result
+}
+
+// SymmetricDifference - Returns a new set with elements that are present in either of the two sets but not in both.
+func (set *ComparableSet[T]) SymmetricDifference(b ComparableSet[T]) ComparableSet[T] {
+	result := set.Difference(b)
+
+	for key := range (&b).Difference(*set) {
+		result[key] = void{}
+	}
+
+	return result
+}
+
+// IsSubsetOf - Returns true if the current set is a subset of the given set b.
+func (set *ComparableSet[T]) IsSubsetOf(b ComparableSet[T]) bool {
+	for key := range *set {
+		if _, found := b[key]; !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals - Returns true if the current set and set b contain the same elements.
+func (set *ComparableSet[T]) Equals(b ComparableSet[T]) bool {
+	return len(*set) == len(b) && set.IsSubsetOf(b)
+}
+
+/*
+	Utility methods
+*/
+
+// Has - Return true if the element is in set, otherwise false
+func (set *ComparableSet[T]) Has(elem T) bool {
+	_, ok := (*set)[elem]
+	return ok
+}
+
+// Len - Return the number of elements
+func (set *ComparableSet[T]) Len() int {
+	return len(*set)
+}
+
+// IsEmpty - Return true if the set is empty, else false
+func (set *ComparableSet[T]) IsEmpty() bool {
+	return len(*set) == 0
+}
+
+// Clear - Remove all elements
+func (set *ComparableSet[T]) Clear() {
+	*set = ComparableSet[T]{}
+}
+
+/*
+	Methods to manipulate a set object
+*/
+
+func (set *ComparableSet[T]) Copy() (ComparableSet[T], error) {
+	if set.IsEmpty() {
+		return nil, errors.New(common.CopyEmpty)
+	}
+
+	elemsCopy := make(ComparableSet[T], len(*set))
+
+	for key := range *set {
+		elemsCopy[key] = void{}
+	}
+
+	return elemsCopy, nil
+}
+
+// ToSlice - Returns a slice of the elements of the map set
+func (set *ComparableSet[T]) ToSlice() ([]T, error) {
+	if set.IsEmpty() {
+		return nil, errors.New(common.EmptySet)
+	}
+
+	result := make([]T, 0, len(*set))
+	for k := range *set {
+		result = append(result, k)
+	}
+	return result, nil
+}
@@ -0,0 +1,197 @@
+package multimap
+
+import (
+	"errors"
+
+	"github.com/rojack96/treje/common"
+	"github.com/rojack96/treje/mapset/types"
+)
+
+// MultiMap - Map associating each key with a set of distinct values
+type MultiMap[K comparable, V comparable] struct {
+	items map[K]types.ComparableSet[V]
+	size  int
+}
+
+// New - Create a new empty multimap
+func New[K comparable, V comparable]() *MultiMap[K, V] {
+	return &MultiMap[K, V]{items: map[K]types.ComparableSet[V]{}}
+}
+
+/*
+	Manipulation map methods
+*/
+
+// Put - Associate a value with a key, return true if the pair is new
+func (m *MultiMap[K, V]) Put(key K, value V) bool {
+	values, ok := m.items[key]
+	if !ok {
+		values = types.ComparableSet[V]{}
+		m.items[key] = values
+	}
+	if values.Has(value) {
+		return false
+	}
+	values.Add(value)
+	m.size++
+	return true
+}
+
+// PutAll - Associate several values with a key, return how many pairs are new
+func (m *MultiMap[K, V]) PutAll(key K, values ...V) int {
+	added := 0
+	for _, value := range values {
+		if m.Put(key, value) {
+			added++
+		}
+	}
+	return added
+}
+
+// Delete - Remove a pair, return true if it was present
+func (m *MultiMap[K, V]) Delete(key K, value V) bool {
+	values, ok := m.items[key]
+	if !ok || !values.Has(value) {
+		return false
+	}
+	delete(values, value)
+	if len(values) == 0 {
+		delete(m.items, key)
+	}
+	m.size--
+	return true
+}
+
+// Remove - Remove a pair, if the key or the value not exists raise an error
+func (m *MultiMap[K, V]) Remove(key K, value V) error {
+	values, ok := m.items[key]
+	if !ok {
+		return errors.New(common.KeyNotExist)
+	}
+	if !values.Has(value) {
+		return errors.New(common.ElemNotExist)
+	}
+	m.Delete(key, value)
+	return nil
+}
+
+// DeleteKey - Remove a key with all its values, return the number of removed pairs
+func (m *MultiMap[K, V]) DeleteKey(key K) int {
+	removed := len(m.items[key])
+	delete(m.items, key)
+	m.size -= removed
+	return removed
+}
+
+// RemoveKey - Remove a key with all its values, if the key not exists raise an error
+func (m *MultiMap[K, V]) RemoveKey(key K) error {
+	if m.DeleteKey(key) == 0 {
+		return errors.New(common.KeyNotExist)
+	}
+	return nil
+}
+
+// Clear - Remove all pairs
+func (m *MultiMap[K, V]) Clear() {
+	m.items = map[K]types.ComparableSet[V]{}
+	m.size = 0
+}
+
+/*
+	Lookup methods
+*/
+
+// Get - Return a copy of the values of a key, empty if the key is absent
+func (m *MultiMap[K, V]) Get(key K) types.ComparableSet[V] {
+	values := make(types.ComparableSet[V], len(m.items[key]))
+	for value := range m.items[key] {
+		values.Add(value)
+	}
+	return values
+}
+
+// HasKey - Return true if the key has at least one value, else false
+func (m *MultiMap[K, V]) HasKey(key K) bool {
+	_, ok := m.items[key]
+	return ok
+}
+
+// Has - Return true if the pair is present, else false
+func (m *MultiMap[K, V]) Has(key K, value V) bool {
+	values := m.items[key]
+	return values.Has(value)
+}
+
+// Count - Return the number of values of a key
+func (m *MultiMap[K, V]) Count(key K) int {
+	return len(m.items[key])
+}
+
+// Len - Return the number of pairs
+func (m *MultiMap[K, V]) Len() int {
+	return m.size
+}
+
+// KeyCount - Return the number of keys
+func (m *MultiMap[K, V]) KeyCount() int {
+	return len(m.items)
+}
+
+// IsEmpty - Return true if the multimap is empty, else false
+func (m *MultiMap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+/*
+	Methods to manipulate a map object
+*/
+
+// Keys - Return the set of keys
+func (m *MultiMap[K, V]) Keys() types.ComparableSet[K] {
+	keys := make(types.ComparableSet[K], len(m.items))
+	for key := range m.items {
+		keys.Add(key)
+	}
+	return keys
+}
+
+// Values - Return the set of values associated with at least one key
+func (m *MultiMap[K, V]) Values() types.ComparableSet[V] {
+	result := types.ComparableSet[V]{}
+	for _, values := range m.items {
+		for value := range values {
+			result.Add(value)
+		}
+	}
+	return result
+}
+
+// Each - Call fn on each pair until it returns false, the order is not specified
+func (m *MultiMap[K, V]) Each(fn func(key K, value V) bool) {
+	for key, values := range m.items {
+		for value := range values {
+			if !fn(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// Inverse - Return a new multimap associating each value with its keys
+func (m *MultiMap[K, V]) Inverse() *MultiMap[V, K] {
+	inverse := New[V, K]()
+	m.Each(func(key K, value V) bool {
+		inverse.Put(value, key)
+		return true
+	})
+	return inverse
+}
+
+// Copy - Return a deep copy of the multimap
+func (m *MultiMap[K, V]) Copy() *MultiMap[K, V] {
+	c := &MultiMap[K, V]{items: make(map[K]types.ComparableSet[V], len(m.items)), size: m.size}
+	for key := range m.items {
+		c.items[key] = m.Get(key)
+	}
+	return c
+}
@@ -0,0 +1,219 @@
+package orderedmap
+
+import (
+	"container/list"
+	"errors"
+
+	"github.com/rojack96/treje/common"
+)
+
+// OrderedMap - Hash map remembering the insertion order of its keys
+type OrderedMap[K comparable, V any] struct {
+	items map[K]*list.Element
+	// order holds the entries from the first to the last inserted
+	order *list.List
+}
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// New - Create a new empty ordered map
+func New[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{items: map[K]*list.Element{}, order: list.New()}
+}
+
+/*
+	Manipulation map methods
+*/
+
+// Put - Insert or replace the value of a key, return true if the key is new.
+// A replaced key keeps its position.
+func (m *OrderedMap[K, V]) Put(key K, value V) bool {
+	if el, ok := m.items[key]; ok {
+		el.Value.(*entry[K, V]).value = value
+		return false
+	}
+	m.items[key] = m.order.PushBack(&entry[K, V]{key: key, value: value})
+	return true
+}
+
+// Delete - Remove a key, return true if the key was present
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	el, ok := m.items[key]
+	if ok {
+		m.order.Remove(el)
+		delete(m.items, key)
+	}
+	return ok
+}
+
+// Remove - Remove a key, if the key not exists raise an error
+func (m *OrderedMap[K, V]) Remove(key K) error {
+	if m.IsEmpty() {
+		return errors.New(common.EmptySet)
+	}
+	if !m.Delete(key) {
+		return errors.New(common.KeyNotExist)
+	}
+	return nil
+}
+
+// Clear - Remove all entries
+func (m *OrderedMap[K, V]) Clear() {
+	m.items = map[K]*list.Element{}
+	m.order.Init()
+}
+
+// MoveToEnd - Make a key the last one, if the key not exists raise an error
+func (m *OrderedMap[K, V]) MoveToEnd(key K) error {
+	el, ok := m.items[key]
+	if !ok {
+		return errors.New(common.KeyNotExist)
+	}
+	m.order.MoveToBack(el)
+	return nil
+}
+
+// MoveToFront - Make a key the first one, if the key not exists raise an error
+func (m *OrderedMap[K, V]) MoveToFront(key K) error {
+	el, ok := m.items[key]
+	if !ok {
+		return errors.New(common.KeyNotExist)
+	}
+	m.order.MoveToFront(el)
+	return nil
+}
+
+// PopFront - Remove and return the first entry, false if the map is empty
+func (m *OrderedMap[K, V]) PopFront() (K, V, bool) {
+	return m.pop(m.order.Front())
+}
+
+// PopBack - Remove and return the last entry, false if the map is empty
+func (m *OrderedMap[K, V]) PopBack() (K, V, bool) {
+	return m.pop(m.order.Back())
+}
+
+/*
+	Lookup methods
+*/
+
+// Get - Return the value of a key and true if the key is present
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if el, ok := m.items[key]; ok {
+		return el.Value.(*entry[K, V]).value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Has - Return true if the key is present, else false
+func (m *OrderedMap[K, V]) Has(key K) bool {
+	_, ok := m.items[key]
+	return ok
+}
+
+// Len - Return the number of entries
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.items)
+}
+
+// IsEmpty - Return true if the map is empty, else false
+func (m *OrderedMap[K, V]) IsEmpty() bool {
+	return len(m.items) == 0
+}
+
+// Front - Return the first entry, false if the map is empty
+func (m *OrderedMap[K, V]) Front() (K, V, bool) {
+	return m.at(m.order.Front())
+}
+
+// Back - Return the last entry, false if the map is empty
+func (m *OrderedMap[K, V]) Back() (K, V, bool) {
+	return m.at(m.order.Back())
+}
+
+/*
+	Iteration methods
+*/
+
+// Each - Call fn on each entry from the first to the last until it returns false
+func (m *OrderedMap[K, V]) Each(fn func(key K, value V) bool) {
+	for el := m.order.Front(); el != nil; el = el.Next() {
+		e := el.Value.(*entry[K, V])
+		if !fn(e.key, e.value) {
+			return
+		}
+	}
+}
+
+// ReverseEach - Call fn on each entry from the last to the first until it returns false
+func (m *OrderedMap[K, V]) ReverseEach(fn func(key K, value V) bool) {
+	for el := m.order.Back(); el != nil; el = el.Prev() {
+		e := el.Value.(*entry[K, V])
+		if !fn(e.key, e.value) {
+			return
+		}
+	}
+}
+
+/*
+	Methods to manipulate a map object
+*/
+
+// Keys - Return all keys in insertion order
+func (m *OrderedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(m.items))
+	m.Each(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Values - Return all values in insertion order
+func (m *OrderedMap[K, V]) Values() []V {
+	values := make([]V, 0, len(m.items))
+	m.Each(func(_ K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+// Copy - Return a copy of the map keeping the order, values are copied shallowly
+func (m *OrderedMap[K, V]) Copy() *OrderedMap[K, V] {
+	c := New[K, V]()
+	m.Each(func(key K, value V) bool {
+		c.Put(key, value)
+		return true
+	})
+	return c
+}
+
+/*
+	Internal helpers
+*/
+
+func (m *OrderedMap[K, V]) at(el *list.Element) (K, V, bool) {
+	if el == nil {
+		var (
+			key   K
+			value V
+		)
+		return key, value, false
+	}
+	e := el.Value.(*entry[K, V])
+	return e.key, e.value, true
+}
+
+func (m *OrderedMap[K, V]) pop(el *list.Element) (K, V, bool) {
+	key, value, ok := m.at(el)
+	if ok {
+		m.order.Remove(el)
+		delete(m.items, key)
+	}
+	return key, value, ok
+}
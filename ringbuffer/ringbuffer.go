@@ -0,0 +1,175 @@
+package ringbuffer
+
+import (
+	"errors"
+
+	"github.com/rojack96/treje/common"
+)
+
+// RingBuffer - Fixed size buffer keeping the latest elements, a push on a full buffer overwrites the oldest.
+// It is not safe for concurrent use, see SPSC for a lock-free variant.
+type RingBuffer[T any] struct {
+	items []T
+	// start is the index of the oldest element
+	start int
+	size  int
+}
+
+// New - Create a new empty buffer holding up to capacity elements
+func New[T any](capacity int) (*RingBuffer[T], error) {
+	if capacity < 1 {
+		return nil, errors.New(common.InvalidCapacity)
+	}
+	return &RingBuffer[T]{items: make([]T, capacity)}, nil
+}
+
+/*
+	Manipulation methods
+*/
+
+// Push - Append an element, return the overwritten oldest element and true if the buffer was full
+func (b *RingBuffer[T]) Push(elem T) (T, bool) {
+	if b.size < len(b.items) {
+		b.items[b.index(b.size)] = elem
+		b.size++
+		var zero T
+		return zero, false
+	}
+
+	oldest := b.items[b.start]
+	b.items[b.start] = elem
+	b.start = b.index(1)
+	return oldest, true
+}
+
+// Pop - Remove and return the oldest element, raise an error if the buffer is empty
+func (b *RingBuffer[T]) Pop() (T, error) {
+	var zero T
+	if b.size == 0 {
+		return zero, errors.New(common.EmptySet)
+	}
+
+	oldest := b.items[b.start]
+	b.items[b.start] = zero
+	b.start = b.index(1)
+	b.size--
+	return oldest, nil
+}
+
+// PopNewest - Remove and return the newest element, raise an error if the buffer is empty
+func (b *RingBuffer[T]) PopNewest() (T, error) {
+	var zero T
+	if b.size == 0 {
+		return zero, errors.New(common.EmptySet)
+	}
+
+	i := b.index(b.size - 1)
+	newest := b.items[i]
+	b.items[i] = zero
+	b.size--
+	return newest, nil
+}
+
+// Set - Replace the element at index i, 0 being the oldest
+func (b *RingBuffer[T]) Set(i int, elem T) error {
+	if i < 0 || i >= b.size {
+		return errors.New(common.IndexOutOfRange)
+	}
+	b.items[b.index(i)] = elem
+	return nil
+}
+
+// Clear - Remove all elements
+func (b *RingBuffer[T]) Clear() {
+	var zero T
+	for i := range b.items {
+		b.items[i] = zero
+	}
+	b.start, b.size = 0, 0
+}
+
+/*
+	Utility methods
+*/
+
+// At - Return the element at index i, 0 being the oldest and Len()-1 the newest
+func (b *RingBuffer[T]) At(i int) (T, error) {
+	if i < 0 || i >= b.size {
+		var zero T
+		return zero, errors.New(common.IndexOutOfRange)
+	}
+	return b.items[b.index(i)], nil
+}
+
+// Oldest - Return the oldest element, false if the buffer is empty
+func (b *RingBuffer[T]) Oldest() (T, bool) {
+	elem, err := b.At(0)
+	return elem, err == nil
+}
+
+// Newest - Return the newest element, false if the buffer is empty
+func (b *RingBuffer[T]) Newest() (T, bool) {
+	elem, err := b.At(b.size - 1)
+	return elem, err == nil
+}
+
+// Len - Return the number of elements
+func (b *RingBuffer[T]) Len() int {
+	return b.size
+}
+
+// Cap - Return the maximum number of elements
+func (b *RingBuffer[T]) Cap() int {
+	return len(b.items)
+}
+
+// IsEmpty - Return true if the buffer is empty, else false
+func (b *RingBuffer[T]) IsEmpty() bool {
+	return b.size == 0
+}
+
+// IsFull - Return true if the next push overwrites an element, else false
+func (b *RingBuffer[T]) IsFull() bool {
+	return b.size == len(b.items)
+}
+
+// Each - Call fn on each element from the oldest to the newest until it returns false
+func (b *RingBuffer[T]) Each(fn func(elem T) bool) {
+	for i := 0; i < b.size; i++ {
+		if !fn(b.items[b.index(i)]) {
+			return
+		}
+	}
+}
+
+/*
+	Methods to manipulate a buffer object
+*/
+
+// Snapshot - Return the elements from the oldest to the newest, empty if the buffer is empty
+func (b *RingBuffer[T]) Snapshot() []T {
+	elems := make([]T, b.size)
+	// The elements are at most two runs of the backing slice
+	n := copy(elems, b.items[b.start:])
+	copy(elems[n:], b.items)
+	return elems
+}
+
+// ToSlice - Return the elements from the oldest to the newest, raise an error if the buffer is empty
+func (b *RingBuffer[T]) ToSlice() ([]T, error) {
+	if b.size == 0 {
+		return nil, errors.New(common.EmptySet)
+	}
+	return b.Snapshot(), nil
+}
+
+// Copy - Return a copy of the buffer with the same capacity
+func (b *RingBuffer[T]) Copy() *RingBuffer[T] {
+	c := &RingBuffer[T]{items: make([]T, len(b.items)), start: b.start, size: b.size}
+	copy(c.items, b.items)
+	return c
+}
+
+func (b *RingBuffer[T]) index(offset int) int {
+	return (b.start + offset) % len(b.items)
+}
@@ -0,0 +1,76 @@
+package ringbuffer
+
+import (
+	"errors"
+	"sync/atomic"
+
+	"github.com/rojack96/treje/common"
+)
+
+// SPSC - Lock-free ring buffer for exactly one producer goroutine and one consumer goroutine.
+// Overwriting would make the producer race with the consumer, so a full buffer rejects pushes instead.
+type SPSC[T any] struct {
+	// head counts the pops, only written by the consumer
+	head uint64
+	_    [56]byte
+	// tail counts the pushes, only written by the producer
+	tail  uint64
+	_     [56]byte
+	items []T
+	mask  uint64
+}
+
+// NewSPSC - Create a new empty buffer holding at least capacity elements,
+// the capacity is rounded up to a power of two
+func NewSPSC[T any](capacity int) (*SPSC[T], error) {
+	if capacity < 1 {
+		return nil, errors.New(common.InvalidCapacity)
+	}
+	size := 1
+	for size < capacity {
+		size <<= 1
+	}
+	return &SPSC[T]{items: make([]T, size), mask: uint64(size - 1)}, nil
+}
+
+// TryPush - Append an element, return false if the buffer is full. Producer only.
+func (b *SPSC[T]) TryPush(elem T) bool {
+	tail := b.tail
+	if tail-atomic.LoadUint64(&b.head) == uint64(len(b.items)) {
+		return false
+	}
+	b.items[tail&b.mask] = elem
+	// Publishing the new tail makes the element visible to the consumer
+	atomic.StoreUint64(&b.tail, tail+1)
+	return true
+}
+
+// TryPop - Remove and return the oldest element, false if the buffer is empty. Consumer only.
+func (b *SPSC[T]) TryPop() (T, bool) {
+	var zero T
+	head := b.head
+	if head == atomic.LoadUint64(&b.tail) {
+		return zero, false
+	}
+	elem := b.items[head&b.mask]
+	b.items[head&b.mask] = zero
+	// Publishing the new head hands the slot back to the producer
+	atomic.StoreUint64(&b.head, head+1)
+	return elem, true
+}
+
+// Len - Return the number of elements, only a hint while both sides are running
+func (b *SPSC[T]) Len() int {
+	head := atomic.LoadUint64(&b.head)
+	return int(atomic.LoadUint64(&b.tail) - head)
+}
+
+// Cap - Return the maximum number of elements
+func (b *SPSC[T]) Cap() int {
+	return len(b.items)
+}
+
+// IsEmpty - Return true if the buffer is empty, only a hint while both sides are running
+func (b *SPSC[T]) IsEmpty() bool {
+	return b.Len() == 0
+}
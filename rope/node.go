@@ -0,0 +1,207 @@
+package rope
+
+import "strings"
+
+// maxLeaf - Largest text kept in one leaf, short leaves are merged up to this size
+const maxLeaf = 512
+
+// node - Immutable rope node, either a leaf holding text or an inner node joining two ropes.
+// Nodes are never modified once built, so ropes share them freely.
+type node struct {
+	text        string
+	left, right *node
+	length      int
+	lines       int // number of '\n' below the node
+	height      int
+}
+
+func leaf(text string) *node {
+	if text == "" {
+		return nil
+	}
+	return &node{text: text, length: len(text), lines: strings.Count(text, "\n"), height: 1}
+}
+
+// fromString - Build a balanced tree over text cut in leaves
+func fromString(text string) *node {
+	if len(text) <= maxLeaf {
+		return leaf(text)
+	}
+	mid := len(text) / 2
+	return inner(fromString(text[:mid]), fromString(text[mid:]))
+}
+
+func inner(left, right *node) *node {
+	h := left.height
+	if right.height > h {
+		h = right.height
+	}
+	return &node{left: left, right: right, length: left.length + right.length, lines: left.lines + right.lines, height: h + 1}
+}
+
+func (n *node) isLeaf() bool {
+	return n.left == nil
+}
+
+func length(n *node) int {
+	if n == nil {
+		return 0
+	}
+	return n.length
+}
+
+func lines(n *node) int {
+	if n == nil {
+		return 0
+	}
+	return n.lines
+}
+
+func height(n *node) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// join - Concatenate two trees keeping the heights of siblings within one of each other
+func join(l, r *node) *node {
+	switch {
+	case l == nil:
+		return r
+	case r == nil:
+		return l
+	case l.isLeaf() && r.isLeaf() && l.length+r.length <= maxLeaf:
+		return leaf(l.text + r.text)
+	case l.height > r.height+1:
+		return balance(l.left, join(l.right, r))
+	case r.height > l.height+1:
+		return balance(join(l, r.left), r.right)
+	}
+	return inner(l, r)
+}
+
+// balance - Build the inner node of two trees whose heights differ by at most two, rotating if needed
+func balance(l, r *node) *node {
+	switch {
+	case l.height > r.height+1:
+		if height(l.left) < height(l.right) {
+			return inner(inner(l.left, l.right.left), inner(l.right.right, r))
+		}
+		return inner(l.left, inner(l.right, r))
+	case r.height > l.height+1:
+		if height(r.right) < height(r.left) {
+			return inner(inner(l, r.left.left), inner(r.left.right, r.right))
+		}
+		return inner(inner(l, r.left), r.right)
+	}
+	return inner(l, r)
+}
+
+// split - Cut a tree in the text before i and the text from i
+func split(n *node, i int) (*node, *node) {
+	switch {
+	case n == nil:
+		return nil, nil
+	case i <= 0:
+		return nil, n
+	case i >= n.length:
+		return n, nil
+	case n.isLeaf():
+		return leaf(n.text[:i]), leaf(n.text[i:])
+	case i < n.left.length:
+		l, r := split(n.left, i)
+		return l, join(r, n.right)
+	default:
+		l, r := split(n.right, i-n.left.length)
+		return join(n.left, l), r
+	}
+}
+
+// byteAt - Return the byte at index i, which must be in range
+func (n *node) byteAt(i int) byte {
+	for !n.isLeaf() {
+		if i < n.left.length {
+			n = n.left
+		} else {
+			i -= n.left.length
+			n = n.right
+		}
+	}
+	return n.text[i]
+}
+
+// newline - Return the index of the k-th '\n', counted from zero, which must exist
+func (n *node) newline(k int) int {
+	offset := 0
+	for !n.isLeaf() {
+		if k < n.left.lines {
+			n = n.left
+		} else {
+			k -= n.left.lines
+			offset += n.left.length
+			n = n.right
+		}
+	}
+	i := -1
+	for ; k >= 0; k-- {
+		i += 1 + strings.IndexByte(n.text[i+1:], '\n')
+	}
+	return offset + i
+}
+
+// linesBefore - Return the number of '\n' in the first i bytes
+func (n *node) linesBefore(i int) int {
+	count := 0
+	for n != nil && i > 0 {
+		if n.isLeaf() {
+			return count + strings.Count(n.text[:i], "\n")
+		}
+		if i < n.left.length {
+			n = n.left
+		} else {
+			count += n.left.lines
+			i -= n.left.length
+			n = n.right
+		}
+	}
+	return count
+}
+
+// walk - Call fn on the leaves overlapping [lo, hi) with the overlapping part of their text
+func (n *node) walk(lo, hi int, fn func(text string) bool) bool {
+	if n == nil || lo >= hi {
+		return true
+	}
+	if n.isLeaf() {
+		return fn(n.text[lo:hi])
+	}
+	if lo < n.left.length {
+		end := hi
+		if end > n.left.length {
+			end = n.left.length
+		}
+		if !n.left.walk(lo, end, fn) {
+			return false
+		}
+	}
+	if hi > n.left.length {
+		start := lo - n.left.length
+		if start < 0 {
+			start = 0
+		}
+		return n.right.walk(start, hi-n.left.length, fn)
+	}
+	return true
+}
+
+// leaves - Append the text of every leaf in order
+func (n *node) leaves(texts []string) []string {
+	if n == nil {
+		return texts
+	}
+	if n.isLeaf() {
+		return append(texts, n.text)
+	}
+	return n.right.leaves(n.left.leaves(texts))
+}
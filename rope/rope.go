@@ -0,0 +1,282 @@
+package rope
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/rojack96/treje/common"
+)
+
+// Rope - Text stored as a balanced tree of string pieces, edited in O(log n).
+// Indexes are byte offsets. Ropes share their immutable pieces, so Copy and Concat are cheap.
+type Rope struct {
+	root *node
+}
+
+// New - Create a new rope holding text
+func New(text string) *Rope {
+	return &Rope{root: fromString(text)}
+}
+
+// FromReader - Create a new rope holding everything read from r
+func FromReader(r io.Reader) (*Rope, error) {
+	rope := &Rope{}
+	if _, err := rope.ReadFrom(r); err != nil {
+		return nil, err
+	}
+	return rope, nil
+}
+
+/*
+	Manipulation methods
+*/
+
+// Insert - Insert text before the byte at index i, Len() appends
+func (r *Rope) Insert(i int, text string) error {
+	if i < 0 || i > r.Len() {
+		return errors.New(common.IndexOutOfRange)
+	}
+	l, rest := split(r.root, i)
+	r.root = join(join(l, fromString(text)), rest)
+	return nil
+}
+
+// Append - Add text at the end
+func (r *Rope) Append(text string) {
+	r.root = join(r.root, fromString(text))
+}
+
+// Delete - Remove the n bytes starting at index i
+func (r *Rope) Delete(i, n int) error {
+	if i < 0 || n < 0 || i+n > r.Len() {
+		return errors.New(common.IndexOutOfRange)
+	}
+	l, rest := split(r.root, i)
+	_, tail := split(rest, n)
+	r.root = join(l, tail)
+	return nil
+}
+
+// Split - Cut the rope at index i, the receiver keeps the text before i and the rest is returned
+func (r *Rope) Split(i int) (*Rope, error) {
+	if i < 0 || i > r.Len() {
+		return nil, errors.New(common.IndexOutOfRange)
+	}
+	l, rest := split(r.root, i)
+	r.root = l
+	return &Rope{root: rest}, nil
+}
+
+// Concat - Return a new rope holding the text of the rope followed by the one of b
+func (r *Rope) Concat(b *Rope) *Rope {
+	return &Rope{root: join(r.root, b.root)}
+}
+
+// Rebalance - Rebuild the tree with full leaves and the smallest height
+func (r *Rope) Rebalance() {
+	r.root = fromString(r.String())
+}
+
+// Clear - Remove all text
+func (r *Rope) Clear() {
+	r.root = nil
+}
+
+/*
+	Lookup methods
+*/
+
+// At - Return the byte at index i
+func (r *Rope) At(i int) (byte, error) {
+	if i < 0 || i >= r.Len() {
+		return 0, errors.New(common.IndexOutOfRange)
+	}
+	return r.root.byteAt(i), nil
+}
+
+// Substring - Return the text of [lo, hi)
+func (r *Rope) Substring(lo, hi int) (string, error) {
+	if lo < 0 || hi > r.Len() || lo > hi {
+		return "", errors.New(common.IndexOutOfRange)
+	}
+	var b strings.Builder
+	b.Grow(hi - lo)
+	r.root.walk(lo, hi, func(text string) bool {
+		b.WriteString(text)
+		return true
+	})
+	return b.String(), nil
+}
+
+// Index - Return the index of the first occurrence of substr, -1 if there is none
+func (r *Rope) Index(substr string) int {
+	if substr == "" {
+		return 0
+	}
+
+	// carry keeps the end of the text already seen, in case a match crosses two leaves
+	carry, offset, found := "", 0, -1
+	r.root.walk(0, r.Len(), func(text string) bool {
+		window := carry + text
+		if i := strings.Index(window, substr); i >= 0 {
+			found = offset - len(carry) + i
+			return false
+		}
+		offset += len(text)
+		if keep := len(substr) - 1; len(window) > keep {
+			window = window[len(window)-keep:]
+		}
+		carry = window
+		return true
+	})
+	return found
+}
+
+// Contains - Return true if substr is in the text, else false
+func (r *Rope) Contains(substr string) bool {
+	return r.Index(substr) >= 0
+}
+
+// Len - Return the length of the text in bytes
+func (r *Rope) Len() int {
+	return length(r.root)
+}
+
+// IsEmpty - Return true if the rope holds no text, else false
+func (r *Rope) IsEmpty() bool {
+	return r.root == nil
+}
+
+// Height - Return the height of the tree, zero when empty
+func (r *Rope) Height() int {
+	return height(r.root)
+}
+
+/*
+	Line methods
+
+	Lines are separated by '\n', a text with k separators has k+1 lines.
+*/
+
+// LineCount - Return the number of lines
+func (r *Rope) LineCount() int {
+	return lines(r.root) + 1
+}
+
+// LineStart - Return the index of the first byte of line n, counted from zero
+func (r *Rope) LineStart(n int) (int, error) {
+	if n < 0 || n >= r.LineCount() {
+		return 0, errors.New(common.IndexOutOfRange)
+	}
+	if n == 0 {
+		return 0, nil
+	}
+	return r.root.newline(n-1) + 1, nil
+}
+
+// Line - Return the text of line n without its separator
+func (r *Rope) Line(n int) (string, error) {
+	start, err := r.LineStart(n)
+	if err != nil {
+		return "", err
+	}
+	end := r.Len()
+	if n < lines(r.root) {
+		end = r.root.newline(n)
+	}
+	return r.Substring(start, end)
+}
+
+// LineOf - Return the line holding the byte at index i, Len() belongs to the last line
+func (r *Rope) LineOf(i int) (int, error) {
+	if i < 0 || i > r.Len() {
+		return 0, errors.New(common.IndexOutOfRange)
+	}
+	return r.root.linesBefore(i), nil
+}
+
+/*
+	Conversion methods
+*/
+
+// String - Return the whole text
+func (r *Rope) String() string {
+	text, _ := r.Substring(0, r.Len())
+	return text
+}
+
+// Copy - Return an independent rope holding the same text, in O(1)
+func (r *Rope) Copy() *Rope {
+	return &Rope{root: r.root}
+}
+
+// Write - Append p to the rope, implements io.Writer
+func (r *Rope) Write(p []byte) (int, error) {
+	r.Append(string(p))
+	return len(p), nil
+}
+
+// WriteString - Append s to the rope, implements io.StringWriter
+func (r *Rope) WriteString(s string) (int, error) {
+	r.Append(s)
+	return len(s), nil
+}
+
+// ReadFrom - Append everything read from src until EOF, implements io.ReaderFrom
+func (r *Rope) ReadFrom(src io.Reader) (int64, error) {
+	var total int64
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := src.Read(buf)
+		if n > 0 {
+			r.Append(string(buf[:n]))
+			total += int64(n)
+		}
+		if err == io.EOF {
+			return total, nil
+		}
+		if err != nil {
+			return total, err
+		}
+	}
+}
+
+// WriteTo - Write the whole text to w piece by piece, implements io.WriterTo
+func (r *Rope) WriteTo(w io.Writer) (int64, error) {
+	var (
+		total int64
+		err   error
+	)
+	r.root.walk(0, r.Len(), func(text string) bool {
+		var n int
+		n, err = io.WriteString(w, text)
+		total += int64(n)
+		return err == nil
+	})
+	return total, err
+}
+
+// Reader - Return a reader over the current text, later edits of the rope do not affect it
+func (r *Rope) Reader() io.Reader {
+	return &reader{pieces: r.root.leaves(nil)}
+}
+
+type reader struct {
+	pieces []string
+}
+
+func (rd *reader) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) && len(rd.pieces) > 0 {
+		c := copy(p[n:], rd.pieces[0])
+		n += c
+		if rd.pieces[0] = rd.pieces[0][c:]; rd.pieces[0] == "" {
+			rd.pieces = rd.pieces[1:]
+		}
+	}
+	if n == 0 && len(p) > 0 {
+		return 0, io.EOF
+	}
+	return n, nil
+}
@@ -0,0 +1,250 @@
+package segment
+
+import (
+	"errors"
+
+	"github.com/rojack96/treje/common"
+)
+
+// Lazy - Describe how pending range updates act on the aggregates
+type Lazy[T any] struct {
+	// Apply - Return the aggregate of size values once update is applied to each of them
+	Apply func(aggregate, update T, size int) T
+	// Compose - Return the single update equivalent to older followed by newer
+	Compose func(older, newer T) T
+}
+
+// SegmentTree - Aggregates of every range of an array under an associative combine function,
+// with point updates and, when built with a Lazy, range updates in O(log n)
+type SegmentTree[T any] struct {
+	n       int
+	tree    []T
+	pending []T
+	dirty   []bool
+	combine func(a, b T) T
+	lazy    *Lazy[T]
+}
+
+// New - Create a tree over a copy of values, combine must be associative
+func New[T any](values []T, combine func(a, b T) T) *SegmentTree[T] {
+	t := &SegmentTree[T]{n: len(values), combine: combine}
+	t.tree = make([]T, 4*len(values))
+	if len(values) > 0 {
+		t.build(1, 0, len(values), values)
+	}
+	return t
+}
+
+// NewLazy - Create a tree supporting range updates described by lazy
+func NewLazy[T any](values []T, combine func(a, b T) T, lazy Lazy[T]) *SegmentTree[T] {
+	t := New(values, combine)
+	t.lazy = &lazy
+	t.pending = make([]T, len(t.tree))
+	t.dirty = make([]bool, len(t.tree))
+	return t
+}
+
+// NewSum - Create a tree of range sums supporting range additions
+func NewSum[T common.Number](values []T) *SegmentTree[T] {
+	return NewLazy(values, func(a, b T) T { return a + b }, Lazy[T]{
+		Apply:   func(aggregate, update T, size int) T { return aggregate + update*T(size) },
+		Compose: func(older, newer T) T { return older + newer },
+	})
+}
+
+// NewMin - Create a tree of range minimums supporting range additions
+func NewMin[T common.Number](values []T) *SegmentTree[T] {
+	return NewLazy(values, func(a, b T) T {
+		if b < a {
+			return b
+		}
+		return a
+	}, additive[T]())
+}
+
+// NewMax - Create a tree of range maximums supporting range additions
+func NewMax[T common.Number](values []T) *SegmentTree[T] {
+	return NewLazy(values, func(a, b T) T {
+		if b > a {
+			return b
+		}
+		return a
+	}, additive[T]())
+}
+
+/*
+	Manipulation methods
+*/
+
+// Set - Replace the value at index i
+func (t *SegmentTree[T]) Set(i int, value T) error {
+	if i < 0 || i >= t.n {
+		return errors.New(common.IndexOutOfRange)
+	}
+	t.set(1, 0, t.n, i, value)
+	return nil
+}
+
+// Update - Apply an update to every value of [lo, hi), the tree must be built with a Lazy
+func (t *SegmentTree[T]) Update(lo, hi int, update T) error {
+	if t.lazy == nil {
+		return errors.New(common.NotSupported)
+	}
+	if lo < 0 || hi > t.n || lo > hi {
+		return errors.New(common.IndexOutOfRange)
+	}
+	if lo < hi {
+		t.update(1, 0, t.n, lo, hi, update)
+	}
+	return nil
+}
+
+/*
+	Query methods
+*/
+
+// Query - Return the combination of the values of [lo, hi), the range must not be empty
+func (t *SegmentTree[T]) Query(lo, hi int) (T, error) {
+	if lo < 0 || hi > t.n || lo >= hi {
+		var zero T
+		return zero, errors.New(common.IndexOutOfRange)
+	}
+	return t.query(1, 0, t.n, lo, hi), nil
+}
+
+// Get - Return the value at index i
+func (t *SegmentTree[T]) Get(i int) (T, error) {
+	return t.Query(i, i+1)
+}
+
+// All - Return the combination of every value, false if the tree is empty
+func (t *SegmentTree[T]) All() (T, bool) {
+	if t.n == 0 {
+		var zero T
+		return zero, false
+	}
+	return t.tree[1], true
+}
+
+// Len - Return the number of values
+func (t *SegmentTree[T]) Len() int {
+	return t.n
+}
+
+// IsEmpty - Return true if the tree has no value, else false
+func (t *SegmentTree[T]) IsEmpty() bool {
+	return t.n == 0
+}
+
+// Values - Return the current values, pending updates included
+func (t *SegmentTree[T]) Values() []T {
+	values := make([]T, 0, t.n)
+	if t.n > 0 {
+		t.collect(1, 0, t.n, &values)
+	}
+	return values
+}
+
+/*
+	Internal helpers
+
+	Node k covers [lo, hi), its children 2k and 2k+1 cover both halves.
+*/
+
+func (t *SegmentTree[T]) build(k, lo, hi int, values []T) {
+	if hi-lo == 1 {
+		t.tree[k] = values[lo]
+		return
+	}
+	mid := (lo + hi) / 2
+	t.build(2*k, lo, mid, values)
+	t.build(2*k+1, mid, hi, values)
+	t.tree[k] = t.combine(t.tree[2*k], t.tree[2*k+1])
+}
+
+// apply - Update the aggregate of a node and remember the update for its children
+func (t *SegmentTree[T]) apply(k, size int, update T) {
+	t.tree[k] = t.lazy.Apply(t.tree[k], update, size)
+	if t.dirty[k] {
+		t.pending[k] = t.lazy.Compose(t.pending[k], update)
+	} else {
+		t.pending[k], t.dirty[k] = update, true
+	}
+}
+
+// push - Hand the pending update of a node down to its children
+func (t *SegmentTree[T]) push(k, lo, hi int) {
+	if t.lazy == nil || !t.dirty[k] {
+		return
+	}
+	mid := (lo + hi) / 2
+	t.apply(2*k, mid-lo, t.pending[k])
+	t.apply(2*k+1, hi-mid, t.pending[k])
+	var zero T
+	t.pending[k], t.dirty[k] = zero, false
+}
+
+func (t *SegmentTree[T]) set(k, lo, hi, i int, value T) {
+	if hi-lo == 1 {
+		t.tree[k] = value
+		return
+	}
+	t.push(k, lo, hi)
+	mid := (lo + hi) / 2
+	if i < mid {
+		t.set(2*k, lo, mid, i, value)
+	} else {
+		t.set(2*k+1, mid, hi, i, value)
+	}
+	t.tree[k] = t.combine(t.tree[2*k], t.tree[2*k+1])
+}
+
+func (t *SegmentTree[T]) update(k, lo, hi, qlo, qhi int, update T) {
+	if qlo <= lo && hi <= qhi {
+		t.apply(k, hi-lo, update)
+		return
+	}
+	t.push(k, lo, hi)
+	mid := (lo + hi) / 2
+	if qlo < mid {
+		t.update(2*k, lo, mid, qlo, qhi, update)
+	}
+	if qhi > mid {
+		t.update(2*k+1, mid, hi, qlo, qhi, update)
+	}
+	t.tree[k] = t.combine(t.tree[2*k], t.tree[2*k+1])
+}
+
+func (t *SegmentTree[T]) query(k, lo, hi, qlo, qhi int) T {
+	if qlo <= lo && hi <= qhi {
+		return t.tree[k]
+	}
+	t.push(k, lo, hi)
+	mid := (lo + hi) / 2
+	switch {
+	case qhi <= mid:
+		return t.query(2*k, lo, mid, qlo, qhi)
+	case qlo >= mid:
+		return t.query(2*k+1, mid, hi, qlo, qhi)
+	}
+	return t.combine(t.query(2*k, lo, mid, qlo, qhi), t.query(2*k+1, mid, hi, qlo, qhi))
+}
+
+func (t *SegmentTree[T]) collect(k, lo, hi int, values *[]T) {
+	if hi-lo == 1 {
+		*values = append(*values, t.tree[k])
+		return
+	}
+	t.push(k, lo, hi)
+	mid := (lo + hi) / 2
+	t.collect(2*k, lo, mid, values)
+	t.collect(2*k+1, mid, hi, values)
+}
+
+// additive - Range additions on minimums or maximums shift the aggregate by the update
+func additive[T common.Number]() Lazy[T] {
+	return Lazy[T]{
+		Apply:   func(aggregate, update T, size int) T { return aggregate + update },
+		Compose: func(older, newer T) T { return older + newer },
+	}
+}
@@ -253,16 +253,16 @@ func (set *Float32Set) Max() float32 {
 }
 
 // Sum - Return a sum of all elements
-func (set *Float32Set) Sum() int {
-	total := 0
+func (set *Float32Set) Sum() float32 {
+	var total Flt32
 
 	if len(*set) > 0 {
 		for _, v := range *set {
-			total += int(v)
+			total += v
 		}
 	}
 
-	return total
+	return float32(total)
 }
 
 // Sort - Sort element in ascending mode
@@ -254,16 +254,16 @@ func (set *Float64Set) Max() float64 {
 }
 
 // Sum - Return a sum of all elements
-func (set *Float64Set) Sum() int {
-	total := 0
+func (set *Float64Set) Sum() float64 {
+	var total Flt64
 
 	if len(*set) > 0 {
 		for _, v := range *set {
-			total += int(v)
+			total += v
 		}
 	}
 
-	return total
+	return float64(total)
 }
 
 // Sort - Sort element in ascending mode
@@ -0,0 +1,21 @@
+package types
+
+import "testing"
+
+func TestFloatSumKeepsFraction(t *testing.T) {
+	f32, err := Set{}.Float32(1.5, 2.25)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := f32.Sum(); got != 3.75 {
+		t.Fatalf("Float32Set sum = %v, want 3.75", got)
+	}
+
+	f64, err := Set{}.Float64(1.5, 2.25, -0.125)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := f64.Sum(); got != 3.625 {
+		t.Fatalf("Float64Set sum = %v, want 3.625", got)
+	}
+}
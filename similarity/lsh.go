@@ -0,0 +1,191 @@
+package similarity
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/rojack96/treje/common"
+	"github.com/rojack96/treje/mapset/types"
+)
+
+// Match - Indexed key with the estimated similarity of its signature to a query
+type Match[K comparable] struct {
+	Key        K
+	Similarity float64
+}
+
+// LSH - Locality sensitive hashing index of MinHash signatures split in bands of rows.
+// Two sets become candidates when one band of their signatures is identical,
+// which happens mostly above a similarity of about Threshold.
+type LSH[K comparable] struct {
+	bands      int
+	rows       int
+	buckets    []map[uint64]types.ComparableSet[K]
+	signatures map[K]Signature
+}
+
+// NewLSH - Create an index for signatures of bands*rows hashes
+func NewLSH[K comparable](bands, rows int) (*LSH[K], error) {
+	if bands < 1 || rows < 1 {
+		return nil, errors.New(common.InvalidSize)
+	}
+
+	buckets := make([]map[uint64]types.ComparableSet[K], bands)
+	for i := range buckets {
+		buckets[i] = map[uint64]types.ComparableSet[K]{}
+	}
+	return &LSH[K]{bands: bands, rows: rows, buckets: buckets, signatures: map[K]Signature{}}, nil
+}
+
+/*
+	Manipulation methods
+*/
+
+// Insert - Index the signature of a key, raise an error if the key is already indexed
+func (l *LSH[K]) Insert(key K, sig Signature) error {
+	if len(sig) != l.bands*l.rows {
+		return errors.New(common.Incompatible)
+	}
+	if _, ok := l.signatures[key]; ok {
+		return errors.New(fmt.Sprint(key) + " " + common.AlreadyExists)
+	}
+
+	sig = sig.Copy()
+	l.signatures[key] = sig
+	for band, bucket := range l.bucketsOf(sig) {
+		set, ok := l.buckets[band][bucket]
+		if !ok {
+			set = types.ComparableSet[K]{}
+			l.buckets[band][bucket] = set
+		}
+		set.Add(key)
+	}
+	return nil
+}
+
+// Remove - Drop a key from the index, raise an error if it is not indexed
+func (l *LSH[K]) Remove(key K) error {
+	sig, ok := l.signatures[key]
+	if !ok {
+		return errors.New(common.ElemNotExist)
+	}
+
+	delete(l.signatures, key)
+	for band, bucket := range l.bucketsOf(sig) {
+		set := l.buckets[band][bucket]
+		delete(set, key)
+		if len(set) == 0 {
+			delete(l.buckets[band], bucket)
+		}
+	}
+	return nil
+}
+
+// Clear - Remove every key
+func (l *LSH[K]) Clear() {
+	for i := range l.buckets {
+		l.buckets[i] = map[uint64]types.ComparableSet[K]{}
+	}
+	l.signatures = map[K]Signature{}
+}
+
+/*
+	Query methods
+*/
+
+// Candidates - Return the keys sharing at least one band with the signature
+func (l *LSH[K]) Candidates(sig Signature) (types.ComparableSet[K], error) {
+	if len(sig) != l.bands*l.rows {
+		return nil, errors.New(common.Incompatible)
+	}
+
+	candidates := types.ComparableSet[K]{}
+	for band, bucket := range l.bucketsOf(sig) {
+		for key := range l.buckets[band][bucket] {
+			candidates.Add(key)
+		}
+	}
+	return candidates, nil
+}
+
+// Query - Return the candidates whose estimated similarity is at least threshold,
+// sorted by decreasing similarity
+func (l *LSH[K]) Query(sig Signature, threshold float64) ([]Match[K], error) {
+	candidates, err := l.Candidates(sig)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]Match[K], 0, len(candidates))
+	for key := range candidates {
+		s, _ := sig.Similarity(l.signatures[key])
+		if s >= threshold {
+			matches = append(matches, Match[K]{Key: key, Similarity: s})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Similarity > matches[j].Similarity })
+	return matches, nil
+}
+
+// Nearest - Return up to n candidates most similar to the signature, sorted by decreasing similarity
+func (l *LSH[K]) Nearest(sig Signature, n int) ([]Match[K], error) {
+	matches, err := l.Query(sig, 0)
+	if err != nil {
+		return nil, err
+	}
+	if n < len(matches) {
+		matches = matches[:n]
+	}
+	return matches, nil
+}
+
+/*
+	Utility methods
+*/
+
+// Signature - Return the indexed signature of a key
+func (l *LSH[K]) Signature(key K) (Signature, bool) {
+	sig, ok := l.signatures[key]
+	return sig, ok
+}
+
+// Has - Return true if the key is indexed, else false
+func (l *LSH[K]) Has(key K) bool {
+	_, ok := l.signatures[key]
+	return ok
+}
+
+// Len - Return the number of indexed keys
+func (l *LSH[K]) Len() int {
+	return len(l.signatures)
+}
+
+// IsEmpty - Return true if no key is indexed, else false
+func (l *LSH[K]) IsEmpty() bool {
+	return len(l.signatures) == 0
+}
+
+// Threshold - Return the similarity at which a pair becomes a candidate with probability 1/2
+// roughly, (1/bands)^(1/rows)
+func (l *LSH[K]) Threshold() float64 {
+	return math.Pow(1/float64(l.bands), 1/float64(l.rows))
+}
+
+/*
+	Internal helpers
+*/
+
+// bucketsOf - Return for each band the hash of its rows
+func (l *LSH[K]) bucketsOf(sig Signature) []uint64 {
+	buckets := make([]uint64, l.bands)
+	for band := range buckets {
+		h := uint64(band)
+		for _, v := range sig[band*l.rows : (band+1)*l.rows] {
+			h = common.Mix64(h ^ v)
+		}
+		buckets[band] = h
+	}
+	return buckets
+}
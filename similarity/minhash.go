@@ -0,0 +1,134 @@
+package similarity
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/rojack96/treje/common"
+)
+
+// Signature - MinHash signature of a set, the minimum of each hash function over its elements
+type Signature []uint64
+
+// MinHash - Family of hash functions computing comparable signatures.
+// Only signatures made by the same MinHash (or one with the same size and seed) can be compared.
+type MinHash[T any] struct {
+	seeds []uint64
+	hash  common.Hasher[T]
+}
+
+// NewMinHash - Create a family of size hash functions for ordered elements, zero seed picks a random one.
+// The error of the estimated similarity is about 1/sqrt(size).
+func NewMinHash[T common.Ordered](size int, seed int64) (*MinHash[T], error) {
+	return NewMinHashFunc(size, seed, common.Hash[T])
+}
+
+// NewMinHashFunc - Create a family of size hash functions using a custom hash
+func NewMinHashFunc[T any](size int, seed int64, hash common.Hasher[T]) (*MinHash[T], error) {
+	if size < 1 {
+		return nil, errors.New(common.InvalidSize)
+	}
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	random := rand.New(rand.NewSource(seed))
+	seeds := make([]uint64, size)
+	for i := range seeds {
+		seeds[i] = random.Uint64()
+	}
+	return &MinHash[T]{seeds: seeds, hash: hash}, nil
+}
+
+// SignMapSet - Return the signature of a map set
+func SignMapSet[T comparable, S ~map[T]V, V any](m *MinHash[T], set S) Signature {
+	sig := m.Empty()
+	for elem := range set {
+		m.Update(sig, elem)
+	}
+	return sig
+}
+
+/*
+	Signing methods
+*/
+
+// Sign - Return the signature of the set made of elems
+func (m *MinHash[T]) Sign(elems ...T) Signature {
+	sig := m.Empty()
+	for _, elem := range elems {
+		m.Update(sig, elem)
+	}
+	return sig
+}
+
+// Empty - Return the signature of the empty set
+func (m *MinHash[T]) Empty() Signature {
+	sig := make(Signature, len(m.seeds))
+	for i := range sig {
+		sig[i] = math.MaxUint64
+	}
+	return sig
+}
+
+// Update - Add an element to a signature in place
+func (m *MinHash[T]) Update(sig Signature, elem T) {
+	h := m.hash(elem)
+	for i, seed := range m.seeds {
+		if v := common.Mix64(h ^ seed); v < sig[i] {
+			sig[i] = v
+		}
+	}
+}
+
+// Size - Return the number of hash functions
+func (m *MinHash[T]) Size() int {
+	return len(m.seeds)
+}
+
+/*
+	Signature methods
+*/
+
+// Similarity - Return the estimated Jaccard similarity of the sets behind two signatures
+func (sig Signature) Similarity(other Signature) (float64, error) {
+	if len(sig) != len(other) {
+		return 0, errors.New(common.Incompatible)
+	}
+	if len(sig) == 0 {
+		return 1, nil
+	}
+
+	equal := 0
+	for i := range sig {
+		if sig[i] == other[i] {
+			equal++
+		}
+	}
+	return float64(equal) / float64(len(sig)), nil
+}
+
+// Union - Return the signature of the union of the sets behind two signatures
+func (sig Signature) Union(other Signature) (Signature, error) {
+	if len(sig) != len(other) {
+		return nil, errors.New(common.Incompatible)
+	}
+
+	result := make(Signature, len(sig))
+	for i := range sig {
+		result[i] = sig[i]
+		if other[i] < result[i] {
+			result[i] = other[i]
+		}
+	}
+	return result, nil
+}
+
+// Copy - Return a copy of the signature
+func (sig Signature) Copy() Signature {
+	c := make(Signature, len(sig))
+	copy(c, sig)
+	return c
+}
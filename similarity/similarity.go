@@ -0,0 +1,90 @@
+package similarity
+
+// Jaccard - Return |A ∩ B| / |A ∪ B| of two map sets, two empty sets are identical
+func Jaccard[T comparable, S ~map[T]V, V any](a, b S) float64 {
+	inter := intersection(a, b)
+	return ratio(inter, len(a)+len(b)-inter)
+}
+
+// Dice - Return 2|A ∩ B| / (|A| + |B|) of two map sets, two empty sets are identical
+func Dice[T comparable, S ~map[T]V, V any](a, b S) float64 {
+	return ratio(2*intersection(a, b), len(a)+len(b))
+}
+
+// Overlap - Return |A ∩ B| / min(|A|, |B|) of two map sets, two empty sets are identical
+func Overlap[T comparable, S ~map[T]V, V any](a, b S) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return ratio(0, len(a)+len(b))
+	}
+	smallest := len(a)
+	if len(b) < smallest {
+		smallest = len(b)
+	}
+	return ratio(intersection(a, b), smallest)
+}
+
+// JaccardSlices - Return |A ∩ B| / |A ∪ B| of two slice based sets
+func JaccardSlices[T comparable, S ~[]T](a, b S) float64 {
+	inter := intersectionSlices(a, b)
+	return ratio(inter, len(a)+len(b)-inter)
+}
+
+// DiceSlices - Return 2|A ∩ B| / (|A| + |B|) of two slice based sets
+func DiceSlices[T comparable, S ~[]T](a, b S) float64 {
+	return ratio(2*intersectionSlices(a, b), len(a)+len(b))
+}
+
+// OverlapSlices - Return |A ∩ B| / min(|A|, |B|) of two slice based sets
+func OverlapSlices[T comparable, S ~[]T](a, b S) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return ratio(0, len(a)+len(b))
+	}
+	smallest := len(a)
+	if len(b) < smallest {
+		smallest = len(b)
+	}
+	return ratio(intersectionSlices(a, b), smallest)
+}
+
+/*
+	Internal helpers
+*/
+
+// ratio - Return num / den, an empty denominator means both sets are empty
+func ratio(num, den int) float64 {
+	if den == 0 {
+		return 1
+	}
+	return float64(num) / float64(den)
+}
+
+func intersection[T comparable, S ~map[T]V, V any](a, b S) int {
+	if len(b) < len(a) {
+		a, b = b, a
+	}
+	count := 0
+	for elem := range a {
+		if _, ok := b[elem]; ok {
+			count++
+		}
+	}
+	return count
+}
+
+func intersectionSlices[T comparable, S ~[]T](a, b S) int {
+	if len(b) < len(a) {
+		a, b = b, a
+	}
+	seen := make(map[T]struct{}, len(a))
+	for _, elem := range a {
+		seen[elem] = struct{}{}
+	}
+	count := 0
+	for _, elem := range b {
+		if _, ok := seen[elem]; ok {
+			count++
+			delete(seen, elem)
+		}
+	}
+	return count
+}
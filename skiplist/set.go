@@ -0,0 +1,209 @@
+package skiplist
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rojack96/treje/common"
+)
+
+type void struct{}
+
+// SkipSet - Ordered set backed by a skip list
+type SkipSet[K any] struct {
+	list *SkipList[K, void]
+}
+
+// NewSet - Create a new set for ordered elements, optionally filled from a slice
+func NewSet[K common.Ordered](options Options, elems ...K) *SkipSet[K] {
+	return NewSetFunc(options, common.Compare[K], elems...)
+}
+
+// NewSetFunc - Create a new set ordered by the given comparator, optionally filled from a slice
+func NewSetFunc[K any](options Options, compare common.Comparator[K], elems ...K) *SkipSet[K] {
+	set := &SkipSet[K]{list: NewFunc[K, void](options, compare)}
+	for _, e := range elems {
+		set.list.Put(e, void{})
+	}
+	return set
+}
+
+/*
+	Manipulation set methods
+*/
+
+// Add - Insert a new element to the set if and only if it is not already present
+func (set *SkipSet[K]) Add(elem K) error {
+	if !set.list.Put(elem, void{}) {
+		return errors.New(fmt.Sprint(elem) + " " + common.AlreadyExists)
+	}
+	return nil
+}
+
+// Remove - Remove a specific element from a set, if the element not exists raise an error
+func (set *SkipSet[K]) Remove(elem K) error {
+	if set.IsEmpty() {
+		return errors.New(common.EmptySet)
+	}
+	if !set.list.Delete(elem) {
+		return errors.New(common.ElemNotExist)
+	}
+	return nil
+}
+
+// Discard - Remove a specific element from set
+func (set *SkipSet[K]) Discard(elem K) {
+	set.list.Delete(elem)
+}
+
+// Pop - Remove and return the smallest element
+func (set *SkipSet[K]) Pop() (K, error) {
+	elem, _, ok := set.list.Min()
+	if !ok {
+		return elem, errors.New(common.EmptySet)
+	}
+	set.list.Delete(elem)
+	return elem, nil
+}
+
+/*
+	Set operation methods
+
+	The result uses the comparator and options of the receiver, b must be ordered the same way.
+*/
+
+// Union - Returns a new set with the elements of both sets
+func (set *SkipSet[K]) Union(b *SkipSet[K]) *SkipSet[K] {
+	return set.merge(b, true, true, true)
+}
+
+// Intersect - Returns the elements that are present in both input sets.
+func (set *SkipSet[K]) Intersect(b *SkipSet[K]) *SkipSet[K] {
+	return set.merge(b, false, true, false)
+}
+
+// Difference - Returns the elements that are present in the first set
+// but not in the second set.
+func (set *SkipSet[K]) Difference(b *SkipSet[K]) *SkipSet[K] {
+	return set.merge(b, true, false, false)
+}
+
+// SymmetricDifference - Returns a new set with elements that are present in either of the two sets but not in both.
+func (set *SkipSet[K]) SymmetricDifference(b *SkipSet[K]) *SkipSet[K] {
+	return set.merge(b, true, false, true)
+}
+
+// IsSubsetOf - Returns true if the current set is a subset of the given set b.
+func (set *SkipSet[K]) IsSubsetOf(b *SkipSet[K]) bool {
+	if set.Len() > b.Len() {
+		return false
+	}
+	subset := true
+	set.Each(func(elem K) bool {
+		subset = b.Has(elem)
+		return subset
+	})
+	return subset
+}
+
+// Equals - Returns true if the current set and set b contain the same elements.
+func (set *SkipSet[K]) Equals(b *SkipSet[K]) bool {
+	return set.Len() == b.Len() && set.IsSubsetOf(b)
+}
+
+/*
+	Utility methods
+*/
+
+// Has - Return true if the element is in set, otherwise false
+func (set *SkipSet[K]) Has(elem K) bool {
+	return set.list.Has(elem)
+}
+
+// Len - Return the number of elements
+func (set *SkipSet[K]) Len() int {
+	return set.list.Len()
+}
+
+// IsEmpty - Return true if the set is empty, else false
+func (set *SkipSet[K]) IsEmpty() bool {
+	return set.list.IsEmpty()
+}
+
+// Clear - Remove all elements
+func (set *SkipSet[K]) Clear() {
+	set.list.Clear()
+}
+
+// Min - Return minimum element from the set
+func (set *SkipSet[K]) Min() (K, error) {
+	elem, _, ok := set.list.Min()
+	if !ok {
+		return elem, errors.New(common.EmptySet)
+	}
+	return elem, nil
+}
+
+// Max - Return maximum element from the set
+func (set *SkipSet[K]) Max() (K, error) {
+	elem, _, ok := set.list.Max()
+	if !ok {
+		return elem, errors.New(common.EmptySet)
+	}
+	return elem, nil
+}
+
+// Each - Visit elements in ascending order until fn returns false
+func (set *SkipSet[K]) Each(fn func(elem K) bool) {
+	set.list.InOrder(func(key K, _ void) bool {
+		return fn(key)
+	})
+}
+
+// Range - Visit elements with lo <= elem < hi in ascending order until fn returns false
+func (set *SkipSet[K]) Range(lo, hi K, fn func(elem K) bool) {
+	set.list.Range(lo, hi, func(key K, _ void) bool {
+		return fn(key)
+	})
+}
+
+// Rank - Return the zero-based position of elem in ascending order and true if it is present
+func (set *SkipSet[K]) Rank(elem K) (int, bool) {
+	return set.list.Rank(elem)
+}
+
+// ByRank - Return the element at the zero-based position in ascending order
+func (set *SkipSet[K]) ByRank(rank int) (K, bool) {
+	k, _, ok := set.list.ByRank(rank)
+	return k, ok
+}
+
+/*
+	Methods to manipulate a set object
+*/
+
+// Copy - Return a deep copy of the set
+func (set *SkipSet[K]) Copy() (*SkipSet[K], error) {
+	if set.IsEmpty() {
+		return nil, errors.New(common.CopyEmpty)
+	}
+	return &SkipSet[K]{list: set.list.Copy()}, nil
+}
+
+// ToSlice - Returns a sorted slice of the elements
+func (set *SkipSet[K]) ToSlice() ([]K, error) {
+	if set.IsEmpty() {
+		return nil, errors.New(common.EmptySet)
+	}
+	return set.list.Keys(), nil
+}
+
+// merge - Walk both sorted sets once, keeping elements only in set (onlyA),
+// in both (both) or only in b (onlyB)
+func (set *SkipSet[K]) merge(b *SkipSet[K], onlyA, both, onlyB bool) *SkipSet[K] {
+	result := &SkipSet[K]{list: NewFunc[K, void](set.list.options, set.list.compare)}
+	common.MergeSorted(set.list.iterator(), b.list.iterator(), set.list.compare, onlyA, both, onlyB, func(elem K) {
+		result.list.Put(elem, void{})
+	})
+	return result
+}
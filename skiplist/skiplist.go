@@ -0,0 +1,358 @@
+package skiplist
+
+import (
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/rojack96/treje/common"
+)
+
+const (
+	// DefaultProbability - Chance for a node to be promoted to the next level
+	DefaultProbability = 0.25
+	// DefaultMaxLevel - Enough levels for 4^32 elements at the default probability
+	DefaultMaxLevel = 32
+)
+
+// Options - Tuning of a skip list, zero values pick the defaults
+type Options struct {
+	// Probability - Chance for a node to reach the next level, between 0 and 1 excluded
+	Probability float64
+	// MaxLevel - Maximum number of levels of a node
+	MaxLevel int
+	// Seed - Seed of the level generator, zero picks a random seed.
+	// A fixed seed makes the shape of the list reproducible.
+	Seed int64
+}
+
+type node[K, V any] struct {
+	key   K
+	value V
+	next  []*node[K, V]
+	// span[i] is the number of level 0 steps covered by next[i]
+	span []int
+}
+
+// SkipList - Ordered map backed by a probabilistic skip list
+type SkipList[K, V any] struct {
+	head    *node[K, V]
+	level   int
+	length  int
+	options Options
+	compare common.Comparator[K]
+	random  *rand.Rand
+}
+
+// New - Create a new empty skip list for ordered keys
+func New[K common.Ordered, V any](options Options) *SkipList[K, V] {
+	return NewFunc[K, V](options, common.Compare[K])
+}
+
+// NewFunc - Create a new empty skip list ordered by the given comparator
+func NewFunc[K, V any](options Options, compare common.Comparator[K]) *SkipList[K, V] {
+	if options.Probability <= 0 || options.Probability >= 1 {
+		options.Probability = DefaultProbability
+	}
+	if options.MaxLevel < 1 {
+		options.MaxLevel = DefaultMaxLevel
+	}
+	if options.Seed == 0 {
+		options.Seed = time.Now().UnixNano()
+	}
+
+	l := &SkipList[K, V]{options: options, compare: compare, random: rand.New(rand.NewSource(options.Seed))}
+	l.Clear()
+	return l
+}
+
+/*
+	Manipulation map methods
+*/
+
+// Put - Insert or replace the value of a key, return true if the key is new
+func (l *SkipList[K, V]) Put(key K, value V) bool {
+	update, rank := l.path(key)
+
+	if next := update[0].next[0]; next != nil && l.compare(next.key, key) == 0 {
+		next.value = value
+		return false
+	}
+
+	level := l.randomLevel()
+	if level > l.level {
+		for i := l.level; i < level; i++ {
+			rank[i] = 0
+			update[i] = l.head
+			update[i].span[i] = l.length
+		}
+		l.level = level
+	}
+
+	n := &node[K, V]{key: key, value: value, next: make([]*node[K, V], level), span: make([]int, level)}
+	for i := 0; i < level; i++ {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+		n.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+	}
+	for i := level; i < l.level; i++ {
+		update[i].span[i]++
+	}
+
+	l.length++
+	return true
+}
+
+// Delete - Remove a key, return true if the key was present
+func (l *SkipList[K, V]) Delete(key K) bool {
+	update, _ := l.path(key)
+
+	n := update[0].next[0]
+	if n == nil || l.compare(n.key, key) != 0 {
+		return false
+	}
+
+	for i := 0; i < l.level; i++ {
+		if update[i].next[i] == n {
+			update[i].span[i] += n.span[i] - 1
+			update[i].next[i] = n.next[i]
+		} else {
+			update[i].span[i]--
+		}
+	}
+	for l.level > 1 && l.head.next[l.level-1] == nil {
+		l.level--
+	}
+
+	l.length--
+	return true
+}
+
+// Remove - Remove a key, if the key not exists raise an error
+func (l *SkipList[K, V]) Remove(key K) error {
+	if l.IsEmpty() {
+		return errors.New(common.EmptySet)
+	}
+	if !l.Delete(key) {
+		return errors.New(common.KeyNotExist)
+	}
+	return nil
+}
+
+// Clear - Remove all entries
+func (l *SkipList[K, V]) Clear() {
+	l.head = &node[K, V]{
+		next: make([]*node[K, V], l.options.MaxLevel),
+		span: make([]int, l.options.MaxLevel),
+	}
+	l.level = 1
+	l.length = 0
+}
+
+/*
+	Lookup methods
+*/
+
+// Get - Return the value of a key and true if the key is present
+func (l *SkipList[K, V]) Get(key K) (V, bool) {
+	if n := l.ceiling(key); n != nil && l.compare(n.key, key) == 0 {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Has - Return true if the key is in the list, otherwise false
+func (l *SkipList[K, V]) Has(key K) bool {
+	_, ok := l.Get(key)
+	return ok
+}
+
+// Len - Return the number of entries
+func (l *SkipList[K, V]) Len() int {
+	return l.length
+}
+
+// IsEmpty - Return true if the list is empty, else false
+func (l *SkipList[K, V]) IsEmpty() bool {
+	return l.length == 0
+}
+
+// Level - Return the number of levels currently in use
+func (l *SkipList[K, V]) Level() int {
+	return l.level
+}
+
+// Min - Return the entry with the smallest key
+func (l *SkipList[K, V]) Min() (K, V, bool) {
+	return l.entry(l.head.next[0])
+}
+
+// Max - Return the entry with the greatest key
+func (l *SkipList[K, V]) Max() (K, V, bool) {
+	return l.ByRank(l.length - 1)
+}
+
+// Rank - Return the zero-based position of key in ascending order and true if the key is present
+func (l *SkipList[K, V]) Rank(key K) (int, bool) {
+	rank, x := 0, l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && l.compare(x.next[i].key, key) <= 0 {
+			rank += x.span[i]
+			x = x.next[i]
+		}
+	}
+
+	if x != l.head && l.compare(x.key, key) == 0 {
+		return rank - 1, true
+	}
+	return 0, false
+}
+
+// ByRank - Return the entry at the zero-based position in ascending order
+func (l *SkipList[K, V]) ByRank(rank int) (K, V, bool) {
+	if rank < 0 || rank >= l.length {
+		return l.entry(nil)
+	}
+
+	traversed, x := 0, l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && traversed+x.span[i] <= rank+1 {
+			traversed += x.span[i]
+			x = x.next[i]
+		}
+		if traversed == rank+1 {
+			break
+		}
+	}
+	return l.entry(x)
+}
+
+/*
+	Iteration methods
+
+	Every scan calls fn once per entry and stops as soon as fn returns false.
+*/
+
+// InOrder - Visit entries in ascending key order
+func (l *SkipList[K, V]) InOrder(fn func(key K, value V) bool) {
+	for n := l.head.next[0]; n != nil; n = n.next[0] {
+		if !fn(n.key, n.value) {
+			return
+		}
+	}
+}
+
+// iterator - Return a pull iterator over the keys in ascending order
+func (l *SkipList[K, V]) iterator() common.Iterator[K] {
+	n := l.head.next[0]
+	return func() (K, bool) {
+		if n == nil {
+			var zero K
+			return zero, false
+		}
+		key := n.key
+		n = n.next[0]
+		return key, true
+	}
+}
+
+// Range - Visit entries with lo <= key < hi in ascending order
+func (l *SkipList[K, V]) Range(lo, hi K, fn func(key K, value V) bool) {
+	for n := l.ceiling(lo); n != nil && l.compare(n.key, hi) < 0; n = n.next[0] {
+		if !fn(n.key, n.value) {
+			return
+		}
+	}
+}
+
+/*
+	Methods to manipulate a list object
+*/
+
+// Keys - Return all keys in ascending order
+func (l *SkipList[K, V]) Keys() []K {
+	keys := make([]K, 0, l.length)
+	l.InOrder(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Values - Return all values ordered by key
+func (l *SkipList[K, V]) Values() []V {
+	values := make([]V, 0, l.length)
+	l.InOrder(func(_ K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+// Copy - Return a copy of the list, its level generator is seeded from this list
+func (l *SkipList[K, V]) Copy() *SkipList[K, V] {
+	options := l.options
+	options.Seed = l.random.Int63() | 1
+
+	c := NewFunc[K, V](options, l.compare)
+	l.InOrder(func(key K, value V) bool {
+		c.Put(key, value)
+		return true
+	})
+	return c
+}
+
+/*
+	Internal helpers
+*/
+
+func (l *SkipList[K, V]) randomLevel() int {
+	level := 1
+	for level < l.options.MaxLevel && l.random.Float64() < l.options.Probability {
+		level++
+	}
+	return level
+}
+
+// path - Return for each level the last node before key and its rank
+func (l *SkipList[K, V]) path(key K) ([]*node[K, V], []int) {
+	update := make([]*node[K, V], l.options.MaxLevel)
+	rank := make([]int, l.options.MaxLevel)
+
+	x := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		if i < l.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.next[i] != nil && l.compare(x.next[i].key, key) < 0 {
+			rank[i] += x.span[i]
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+	return update, rank
+}
+
+// ceiling - Return the first node with a key greater than or equal to key
+func (l *SkipList[K, V]) ceiling(key K) *node[K, V] {
+	x := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && l.compare(x.next[i].key, key) < 0 {
+			x = x.next[i]
+		}
+	}
+	return x.next[0]
+}
+
+func (l *SkipList[K, V]) entry(n *node[K, V]) (K, V, bool) {
+	if n == nil {
+		var (
+			key   K
+			value V
+		)
+		return key, value, false
+	}
+	return n.key, n.value, true
+}
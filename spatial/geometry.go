@@ -0,0 +1,130 @@
+package spatial
+
+import (
+	"container/heap"
+	"math"
+	"sort"
+)
+
+// Point - Point of the plane
+type Point struct {
+	X float64
+	Y float64
+}
+
+// Distance - Return the euclidean distance to q
+func (p Point) Distance(q Point) float64 {
+	return math.Hypot(p.X-q.X, p.Y-q.Y)
+}
+
+// Rect - Axis aligned rectangle, both corners included
+type Rect struct {
+	Min Point
+	Max Point
+}
+
+// NewRect - Return the rectangle spanned by two opposite corners in any order
+func NewRect(a, b Point) Rect {
+	return Rect{
+		Min: Point{math.Min(a.X, b.X), math.Min(a.Y, b.Y)},
+		Max: Point{math.Max(a.X, b.X), math.Max(a.Y, b.Y)},
+	}
+}
+
+// PointRect - Return the degenerate rectangle holding only p
+func PointRect(p Point) Rect {
+	return Rect{Min: p, Max: p}
+}
+
+// Contains - Return true if the point lies in the rectangle, else false
+func (r Rect) Contains(p Point) bool {
+	return r.Min.X <= p.X && p.X <= r.Max.X && r.Min.Y <= p.Y && p.Y <= r.Max.Y
+}
+
+// ContainsRect - Return true if b lies entirely in the rectangle, else false
+func (r Rect) ContainsRect(b Rect) bool {
+	return r.Min.X <= b.Min.X && b.Max.X <= r.Max.X && r.Min.Y <= b.Min.Y && b.Max.Y <= r.Max.Y
+}
+
+// Intersects - Return true if both rectangles share at least one point, else false
+func (r Rect) Intersects(b Rect) bool {
+	return r.Min.X <= b.Max.X && b.Min.X <= r.Max.X && r.Min.Y <= b.Max.Y && b.Min.Y <= r.Max.Y
+}
+
+// Union - Return the smallest rectangle holding both rectangles
+func (r Rect) Union(b Rect) Rect {
+	return Rect{
+		Min: Point{math.Min(r.Min.X, b.Min.X), math.Min(r.Min.Y, b.Min.Y)},
+		Max: Point{math.Max(r.Max.X, b.Max.X), math.Max(r.Max.Y, b.Max.Y)},
+	}
+}
+
+// Area - Return the area of the rectangle
+func (r Rect) Area() float64 {
+	return (r.Max.X - r.Min.X) * (r.Max.Y - r.Min.Y)
+}
+
+// Center - Return the center of the rectangle
+func (r Rect) Center() Point {
+	return Point{(r.Min.X + r.Max.X) / 2, (r.Min.Y + r.Max.Y) / 2}
+}
+
+// Distance - Return the distance from the point to the closest point of the rectangle
+func (r Rect) Distance(p Point) float64 {
+	dx := math.Max(0, math.Max(r.Min.X-p.X, p.X-r.Max.X))
+	dy := math.Max(0, math.Max(r.Min.Y-p.Y, p.Y-r.Max.Y))
+	return math.Hypot(dx, dy)
+}
+
+// Neighbor - Indexed element found by a nearest neighbor search with its distance to the query
+type Neighbor[K comparable] struct {
+	ID       K
+	Distance float64
+}
+
+/*
+	Internal helpers
+*/
+
+// nearest - Bounded max heap keeping the k closest neighbors seen so far
+type nearest[K comparable] struct {
+	k     int
+	items []Neighbor[K]
+}
+
+func (h *nearest[K]) Len() int           { return len(h.items) }
+func (h *nearest[K]) Less(i, j int) bool { return h.items[i].Distance > h.items[j].Distance }
+func (h *nearest[K]) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *nearest[K]) Push(x any)         { h.items = append(h.items, x.(Neighbor[K])) }
+func (h *nearest[K]) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
+
+// offer - Keep the neighbor if it is among the k closest
+func (h *nearest[K]) offer(id K, distance float64) {
+	switch {
+	case len(h.items) < h.k:
+		heap.Push(h, Neighbor[K]{id, distance})
+	case distance < h.items[0].Distance:
+		h.items[0] = Neighbor[K]{id, distance}
+		heap.Fix(h, 0)
+	}
+}
+
+// bound - Return the distance a candidate must beat to be kept
+func (h *nearest[K]) bound() float64 {
+	if len(h.items) < h.k {
+		return math.Inf(1)
+	}
+	return h.items[0].Distance
+}
+
+// sorted - Return the neighbors from the closest to the farthest
+func (h *nearest[K]) sorted() []Neighbor[K] {
+	result := make([]Neighbor[K], len(h.items))
+	copy(result, h.items)
+	sort.SliceStable(result, func(i, j int) bool { return result[i].Distance < result[j].Distance })
+	return result
+}
@@ -0,0 +1,291 @@
+package spatial
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/rojack96/treje/common"
+	"github.com/rojack96/treje/mapset/types"
+)
+
+// KDTree - k-d tree of identified points of a fixed number of dimensions.
+// Removed points are only marked and dropped by a rebuild once they outnumber the live ones.
+type KDTree[K comparable] struct {
+	dims   int
+	root   *kdNode[K]
+	points map[K][]float64
+	dead   int
+}
+
+type kdNode[K comparable] struct {
+	id          K
+	coords      []float64
+	axis        int
+	deleted     bool
+	left, right *kdNode[K]
+}
+
+// NewKDTree - Create a new tree of points of dims coordinates
+func NewKDTree[K comparable](dims int) (*KDTree[K], error) {
+	if dims < 1 {
+		return nil, errors.New(common.InvalidSize)
+	}
+	return &KDTree[K]{dims: dims, points: map[K][]float64{}}, nil
+}
+
+/*
+	Manipulation methods
+*/
+
+// Insert - Add a point, raise an error if the id is already present or the dimensions differ
+func (t *KDTree[K]) Insert(id K, coords ...float64) error {
+	if len(coords) != t.dims {
+		return errors.New(common.InvalidSize)
+	}
+	if _, ok := t.points[id]; ok {
+		return errors.New(fmt.Sprint(id) + " " + common.AlreadyExists)
+	}
+
+	p := make([]float64, t.dims)
+	copy(p, coords)
+	t.points[id] = p
+
+	link := &t.root
+	axis := 0
+	for *link != nil {
+		n := *link
+		if p[n.axis] < n.coords[n.axis] {
+			link = &n.left
+		} else {
+			link = &n.right
+		}
+		axis = (n.axis + 1) % t.dims
+	}
+	*link = &kdNode[K]{id: id, coords: p, axis: axis}
+	return nil
+}
+
+// Remove - Remove a point, raise an error if the id is not present
+func (t *KDTree[K]) Remove(id K) error {
+	p, ok := t.points[id]
+	if !ok {
+		return errors.New(common.ElemNotExist)
+	}
+
+	delete(t.points, id)
+	t.find(t.root, id, p).deleted = true
+	t.dead++
+	if t.dead > len(t.points) {
+		t.Rebuild()
+	}
+	return nil
+}
+
+// Rebuild - Rebuild a balanced tree from the live points
+func (t *KDTree[K]) Rebuild() {
+	nodes := make([]*kdNode[K], 0, len(t.points))
+	for id, p := range t.points {
+		nodes = append(nodes, &kdNode[K]{id: id, coords: p})
+	}
+	t.root = t.build(nodes, 0)
+	t.dead = 0
+}
+
+// Clear - Remove all points
+func (t *KDTree[K]) Clear() {
+	t.root = nil
+	t.points = map[K][]float64{}
+	t.dead = 0
+}
+
+/*
+	Query methods
+*/
+
+// Nearest - Return the closest point to the query, false if the tree is empty
+func (t *KDTree[K]) Nearest(query ...float64) (Neighbor[K], bool) {
+	result := t.KNearest(1, query...)
+	if len(result) == 0 {
+		return Neighbor[K]{}, false
+	}
+	return result[0], true
+}
+
+// KNearest - Return up to k points closest to the query, from the closest to the farthest
+func (t *KDTree[K]) KNearest(k int, query ...float64) []Neighbor[K] {
+	if k < 1 || len(query) != t.dims {
+		return nil
+	}
+	h := &nearest[K]{k: k}
+	t.nearest(t.root, query, h)
+	return h.sorted()
+}
+
+// Radius - Return the ids of the points within distance r of the query
+func (t *KDTree[K]) Radius(r float64, query ...float64) types.ComparableSet[K] {
+	result := types.ComparableSet[K]{}
+	if len(query) == t.dims {
+		t.radius(t.root, query, r, result)
+	}
+	return result
+}
+
+// InBox - Return the ids of the points whose every coordinate lies within [lo[i], hi[i]]
+func (t *KDTree[K]) InBox(lo, hi []float64) (types.ComparableSet[K], error) {
+	if len(lo) != t.dims || len(hi) != t.dims {
+		return nil, errors.New(common.InvalidSize)
+	}
+	result := types.ComparableSet[K]{}
+	t.box(t.root, lo, hi, result)
+	return result, nil
+}
+
+/*
+	Utility methods
+*/
+
+// Point - Return the coordinates of a point, false if the id is not present
+func (t *KDTree[K]) Point(id K) ([]float64, bool) {
+	p, ok := t.points[id]
+	if !ok {
+		return nil, false
+	}
+	c := make([]float64, len(p))
+	copy(c, p)
+	return c, true
+}
+
+// Has - Return true if the id is present, else false
+func (t *KDTree[K]) Has(id K) bool {
+	_, ok := t.points[id]
+	return ok
+}
+
+// Len - Return the number of points
+func (t *KDTree[K]) Len() int {
+	return len(t.points)
+}
+
+// IsEmpty - Return true if the tree is empty, else false
+func (t *KDTree[K]) IsEmpty() bool {
+	return len(t.points) == 0
+}
+
+// Dims - Return the number of coordinates of the points
+func (t *KDTree[K]) Dims() int {
+	return t.dims
+}
+
+// IDs - Return the ids of every point
+func (t *KDTree[K]) IDs() types.ComparableSet[K] {
+	result := make(types.ComparableSet[K], len(t.points))
+	for id := range t.points {
+		result.Add(id)
+	}
+	return result
+}
+
+/*
+	Internal helpers
+*/
+
+// build - Split the nodes on the median of the axis, cycling through the axes
+func (t *KDTree[K]) build(nodes []*kdNode[K], axis int) *kdNode[K] {
+	if len(nodes) == 0 {
+		return nil
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].coords[axis] < nodes[j].coords[axis] })
+
+	// Equal coordinates must end on the right, as Insert sends them there
+	mid := len(nodes) / 2
+	for mid > 0 && nodes[mid-1].coords[axis] == nodes[mid].coords[axis] {
+		mid--
+	}
+	n := nodes[mid]
+	n.axis = axis
+	next := (axis + 1) % t.dims
+	n.left = t.build(nodes[:mid], next)
+	n.right = t.build(nodes[mid+1:], next)
+	return n
+}
+
+func (t *KDTree[K]) find(n *kdNode[K], id K, p []float64) *kdNode[K] {
+	for n != nil {
+		if !n.deleted && n.id == id {
+			return n
+		}
+		if p[n.axis] < n.coords[n.axis] {
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	return nil
+}
+
+func (t *KDTree[K]) nearest(n *kdNode[K], query []float64, h *nearest[K]) {
+	if n == nil {
+		return
+	}
+	if !n.deleted {
+		h.offer(n.id, distance(n.coords, query))
+	}
+
+	diff := query[n.axis] - n.coords[n.axis]
+	near, far := n.left, n.right
+	if diff >= 0 {
+		near, far = far, near
+	}
+	t.nearest(near, query, h)
+	if math.Abs(diff) <= h.bound() {
+		t.nearest(far, query, h)
+	}
+}
+
+func (t *KDTree[K]) radius(n *kdNode[K], query []float64, r float64, result types.ComparableSet[K]) {
+	if n == nil {
+		return
+	}
+	if !n.deleted && distance(n.coords, query) <= r {
+		result.Add(n.id)
+	}
+	if query[n.axis]-r < n.coords[n.axis] {
+		t.radius(n.left, query, r, result)
+	}
+	if query[n.axis]+r >= n.coords[n.axis] {
+		t.radius(n.right, query, r, result)
+	}
+}
+
+func (t *KDTree[K]) box(n *kdNode[K], lo, hi []float64, result types.ComparableSet[K]) {
+	if n == nil {
+		return
+	}
+	inside := !n.deleted
+	for i, c := range n.coords {
+		if c < lo[i] || c > hi[i] {
+			inside = false
+			break
+		}
+	}
+	if inside {
+		result.Add(n.id)
+	}
+	if lo[n.axis] < n.coords[n.axis] {
+		t.box(n.left, lo, hi, result)
+	}
+	if hi[n.axis] >= n.coords[n.axis] {
+		t.box(n.right, lo, hi, result)
+	}
+}
+
+func distance(a, b []float64) float64 {
+	sum := 0.0
+	for i := range a {
+		d := a[i] - b[i]
+		sum += d * d
+	}
+	return math.Sqrt(sum)
+}
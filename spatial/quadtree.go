@@ -0,0 +1,243 @@
+package spatial
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/rojack96/treje/common"
+	"github.com/rojack96/treje/mapset/types"
+)
+
+// QuadTree - Point quadtree, every point splits the plane around it into four quadrants.
+// Removed points are only marked and dropped by a rebuild once they outnumber the live ones.
+type QuadTree[K comparable] struct {
+	root   *quadNode[K]
+	points map[K]Point
+	dead   int
+}
+
+type quadNode[K comparable] struct {
+	id       K
+	point    Point
+	deleted  bool
+	children [4]*quadNode[K]
+}
+
+// Quadrants are numbered by their side of the node: bit 0 set when X >= node X, bit 1 when Y >= node Y
+const (
+	southWest = iota
+	southEast
+	northWest
+	northEast
+)
+
+// NewQuadTree - Create a new empty quadtree
+func NewQuadTree[K comparable]() *QuadTree[K] {
+	return &QuadTree[K]{points: map[K]Point{}}
+}
+
+/*
+	Manipulation methods
+*/
+
+// Insert - Add a point, raise an error if the id is already present
+func (t *QuadTree[K]) Insert(id K, p Point) error {
+	if _, ok := t.points[id]; ok {
+		return errors.New(fmt.Sprint(id) + " " + common.AlreadyExists)
+	}
+	t.points[id] = p
+	t.insert(&quadNode[K]{id: id, point: p})
+	return nil
+}
+
+// Remove - Remove a point, raise an error if the id is not present
+func (t *QuadTree[K]) Remove(id K) error {
+	p, ok := t.points[id]
+	if !ok {
+		return errors.New(common.ElemNotExist)
+	}
+
+	delete(t.points, id)
+	for n := t.root; n != nil; n = n.children[quadrant(n.point, p)] {
+		if !n.deleted && n.id == id {
+			n.deleted = true
+			break
+		}
+	}
+	t.dead++
+	if t.dead > len(t.points) {
+		t.Rebuild()
+	}
+	return nil
+}
+
+// Rebuild - Rebuild the tree from the live points
+func (t *QuadTree[K]) Rebuild() {
+	t.root, t.dead = nil, 0
+	for id, p := range t.points {
+		t.insert(&quadNode[K]{id: id, point: p})
+	}
+}
+
+// Clear - Remove all points
+func (t *QuadTree[K]) Clear() {
+	t.root = nil
+	t.points = map[K]Point{}
+	t.dead = 0
+}
+
+/*
+	Query methods
+*/
+
+// InRect - Return the ids of the points lying in the rectangle
+func (t *QuadTree[K]) InRect(r Rect) types.ComparableSet[K] {
+	result := types.ComparableSet[K]{}
+	t.inRect(t.root, r, func(n *quadNode[K]) {
+		result.Add(n.id)
+	})
+	return result
+}
+
+// Radius - Return the ids of the points within distance r of the query
+func (t *QuadTree[K]) Radius(query Point, r float64) types.ComparableSet[K] {
+	result := types.ComparableSet[K]{}
+	box := Rect{Point{query.X - r, query.Y - r}, Point{query.X + r, query.Y + r}}
+	t.inRect(t.root, box, func(n *quadNode[K]) {
+		if n.point.Distance(query) <= r {
+			result.Add(n.id)
+		}
+	})
+	return result
+}
+
+// Nearest - Return the closest point to the query, false if the tree is empty
+func (t *QuadTree[K]) Nearest(query Point) (Neighbor[K], bool) {
+	result := t.KNearest(query, 1)
+	if len(result) == 0 {
+		return Neighbor[K]{}, false
+	}
+	return result[0], true
+}
+
+// KNearest - Return up to k points closest to the query, from the closest to the farthest
+func (t *QuadTree[K]) KNearest(query Point, k int) []Neighbor[K] {
+	if k < 1 {
+		return nil
+	}
+	h := &nearest[K]{k: k}
+	t.nearest(t.root, query, h)
+	return h.sorted()
+}
+
+/*
+	Utility methods
+*/
+
+// Point - Return the coordinates of a point, false if the id is not present
+func (t *QuadTree[K]) Point(id K) (Point, bool) {
+	p, ok := t.points[id]
+	return p, ok
+}
+
+// Has - Return true if the id is present, else false
+func (t *QuadTree[K]) Has(id K) bool {
+	_, ok := t.points[id]
+	return ok
+}
+
+// Len - Return the number of points
+func (t *QuadTree[K]) Len() int {
+	return len(t.points)
+}
+
+// IsEmpty - Return true if the tree is empty, else false
+func (t *QuadTree[K]) IsEmpty() bool {
+	return len(t.points) == 0
+}
+
+// IDs - Return the ids of every point
+func (t *QuadTree[K]) IDs() types.ComparableSet[K] {
+	result := make(types.ComparableSet[K], len(t.points))
+	for id := range t.points {
+		result.Add(id)
+	}
+	return result
+}
+
+/*
+	Internal helpers
+*/
+
+func quadrant(center, p Point) int {
+	q := southWest
+	if p.X >= center.X {
+		q |= southEast
+	}
+	if p.Y >= center.Y {
+		q |= northWest
+	}
+	return q
+}
+
+func (t *QuadTree[K]) insert(node *quadNode[K]) {
+	link := &t.root
+	for *link != nil {
+		n := *link
+		link = &n.children[quadrant(n.point, node.point)]
+	}
+	*link = node
+}
+
+func (t *QuadTree[K]) inRect(n *quadNode[K], r Rect, fn func(n *quadNode[K])) {
+	if n == nil {
+		return
+	}
+	if !n.deleted && r.Contains(n.point) {
+		fn(n)
+	}
+
+	west, east := r.Min.X < n.point.X, r.Max.X >= n.point.X
+	south, north := r.Min.Y < n.point.Y, r.Max.Y >= n.point.Y
+	if west && south {
+		t.inRect(n.children[southWest], r, fn)
+	}
+	if east && south {
+		t.inRect(n.children[southEast], r, fn)
+	}
+	if west && north {
+		t.inRect(n.children[northWest], r, fn)
+	}
+	if east && north {
+		t.inRect(n.children[northEast], r, fn)
+	}
+}
+
+func (t *QuadTree[K]) nearest(n *quadNode[K], query Point, h *nearest[K]) {
+	if n == nil {
+		return
+	}
+	if !n.deleted {
+		h.offer(n.id, n.point.Distance(query))
+	}
+
+	// Visit the quadrant of the query first, then the others while they may hold a closer point
+	home := quadrant(n.point, query)
+	t.nearest(n.children[home], query, h)
+	for q := range n.children {
+		if q == home || n.children[q] == nil {
+			continue
+		}
+		dx, dy := 0.0, 0.0
+		if q&southEast != home&southEast {
+			dx = math.Abs(query.X - n.point.X)
+		}
+		if q&northWest != home&northWest {
+			dy = math.Abs(query.Y - n.point.Y)
+		}
+		if math.Hypot(dx, dy) <= h.bound() {
+			t.nearest(n.children[q], query, h)
+		}
+	}
+}
@@ -0,0 +1,453 @@
+package spatial
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/rojack96/treje/common"
+	"github.com/rojack96/treje/mapset/types"
+)
+
+// DefaultMaxEntries - Entries per R-tree node when none is given
+const DefaultMaxEntries = 16
+
+// Item - Identified rectangle stored in an R-tree
+type Item[K comparable] struct {
+	ID   K
+	Rect Rect
+}
+
+// RTree - R-tree of identified rectangles with quadratic node splits
+// and Sort-Tile-Recursive bulk loading
+type RTree[K comparable] struct {
+	root       *rNode[K]
+	height     int
+	maxEntries int
+	minEntries int
+	rects      map[K]Rect
+}
+
+type rNode[K comparable] struct {
+	leaf    bool
+	entries []rEntry[K]
+}
+
+// rEntry - Rectangle of a node, leaf entries carry an id and inner entries a child
+type rEntry[K comparable] struct {
+	rect  Rect
+	id    K
+	child *rNode[K]
+}
+
+// NewRTree - Create a new tree whose nodes hold up to maxEntries, DefaultMaxEntries if below 4
+func NewRTree[K comparable](maxEntries int) *RTree[K] {
+	if maxEntries < 4 {
+		maxEntries = DefaultMaxEntries
+	}
+	return &RTree[K]{
+		root:       &rNode[K]{leaf: true},
+		height:     1,
+		maxEntries: maxEntries,
+		minEntries: maxEntries * 2 / 5,
+		rects:      map[K]Rect{},
+	}
+}
+
+/*
+	Manipulation methods
+*/
+
+// Insert - Add a rectangle, raise an error if the id is already present
+func (t *RTree[K]) Insert(id K, r Rect) error {
+	if _, ok := t.rects[id]; ok {
+		return errors.New(fmt.Sprint(id) + " " + common.AlreadyExists)
+	}
+	t.rects[id] = r
+	t.insert(rEntry[K]{rect: r, id: id})
+	return nil
+}
+
+// Remove - Remove a rectangle, raise an error if the id is not present.
+// Nodes left underfull are dissolved and their entries inserted again.
+func (t *RTree[K]) Remove(id K) error {
+	r, ok := t.rects[id]
+	if !ok {
+		return errors.New(common.ElemNotExist)
+	}
+	delete(t.rects, id)
+
+	var orphans []rEntry[K]
+	t.remove(t.root, id, r, &orphans)
+	for !t.root.leaf && len(t.root.entries) == 1 {
+		t.root = t.root.entries[0].child
+		t.height--
+	}
+	if !t.root.leaf && len(t.root.entries) == 0 {
+		t.root, t.height = &rNode[K]{leaf: true}, 1
+	}
+	for _, e := range orphans {
+		t.insert(e)
+	}
+	return nil
+}
+
+// Load - Replace the content of the tree with items, packed bottom-up by Sort-Tile-Recursive.
+// Raise an error if an id appears twice.
+func (t *RTree[K]) Load(items []Item[K]) error {
+	rects := make(map[K]Rect, len(items))
+	entries := make([]rEntry[K], len(items))
+	for i, item := range items {
+		if _, ok := rects[item.ID]; ok {
+			return errors.New(fmt.Sprint(item.ID) + " " + common.AlreadyExists)
+		}
+		rects[item.ID] = item.Rect
+		entries[i] = rEntry[K]{rect: item.Rect, id: item.ID}
+	}
+
+	t.rects = rects
+	t.root, t.height = &rNode[K]{leaf: true}, 1
+	if len(entries) == 0 {
+		return nil
+	}
+
+	leaf := true
+	for {
+		nodes := t.pack(entries, leaf)
+		if len(nodes) == 1 {
+			t.root = nodes[0]
+			return nil
+		}
+		entries = make([]rEntry[K], len(nodes))
+		for i, n := range nodes {
+			entries[i] = rEntry[K]{rect: n.bounds(), child: n}
+		}
+		leaf = false
+		t.height++
+	}
+}
+
+// Clear - Remove all rectangles
+func (t *RTree[K]) Clear() {
+	t.root, t.height = &rNode[K]{leaf: true}, 1
+	t.rects = map[K]Rect{}
+}
+
+/*
+	Query methods
+*/
+
+// Search - Return the ids of the rectangles sharing a point with r
+func (t *RTree[K]) Search(r Rect) types.ComparableSet[K] {
+	result := types.ComparableSet[K]{}
+	t.search(t.root, r, func(e rEntry[K]) {
+		result.Add(e.id)
+	})
+	return result
+}
+
+// Within - Return the ids of the rectangles lying entirely in r
+func (t *RTree[K]) Within(r Rect) types.ComparableSet[K] {
+	result := types.ComparableSet[K]{}
+	t.search(t.root, r, func(e rEntry[K]) {
+		if r.ContainsRect(e.rect) {
+			result.Add(e.id)
+		}
+	})
+	return result
+}
+
+// Containing - Return the ids of the rectangles holding the point
+func (t *RTree[K]) Containing(p Point) types.ComparableSet[K] {
+	return t.Search(PointRect(p))
+}
+
+// KNearest - Return up to k rectangles closest to the point, from the closest to the farthest.
+// Nodes are visited best first on their distance to the point.
+func (t *RTree[K]) KNearest(p Point, k int) []Neighbor[K] {
+	if k < 1 {
+		return nil
+	}
+
+	h := &nearest[K]{k: k}
+	queue := &rQueue[K]{{node: t.root}}
+	for queue.Len() > 0 {
+		next := heap.Pop(queue).(rQueued[K])
+		if next.distance > h.bound() {
+			break
+		}
+		for _, e := range next.node.entries {
+			d := e.rect.Distance(p)
+			if next.node.leaf {
+				h.offer(e.id, d)
+			} else if d <= h.bound() {
+				heap.Push(queue, rQueued[K]{node: e.child, distance: d})
+			}
+		}
+	}
+	return h.sorted()
+}
+
+// Nearest - Return the closest rectangle to the point, false if the tree is empty
+func (t *RTree[K]) Nearest(p Point) (Neighbor[K], bool) {
+	result := t.KNearest(p, 1)
+	if len(result) == 0 {
+		return Neighbor[K]{}, false
+	}
+	return result[0], true
+}
+
+/*
+	Utility methods
+*/
+
+// Rect - Return the rectangle of an id, false if the id is not present
+func (t *RTree[K]) Rect(id K) (Rect, bool) {
+	r, ok := t.rects[id]
+	return r, ok
+}
+
+// Bounds - Return the smallest rectangle holding every rectangle, false if the tree is empty
+func (t *RTree[K]) Bounds() (Rect, bool) {
+	if len(t.root.entries) == 0 {
+		return Rect{}, false
+	}
+	return t.root.bounds(), true
+}
+
+// Has - Return true if the id is present, else false
+func (t *RTree[K]) Has(id K) bool {
+	_, ok := t.rects[id]
+	return ok
+}
+
+// Len - Return the number of rectangles
+func (t *RTree[K]) Len() int {
+	return len(t.rects)
+}
+
+// IsEmpty - Return true if the tree is empty, else false
+func (t *RTree[K]) IsEmpty() bool {
+	return len(t.rects) == 0
+}
+
+// Height - Return the number of levels of the tree
+func (t *RTree[K]) Height() int {
+	return t.height
+}
+
+// IDs - Return the ids of every rectangle
+func (t *RTree[K]) IDs() types.ComparableSet[K] {
+	result := make(types.ComparableSet[K], len(t.rects))
+	for id := range t.rects {
+		result.Add(id)
+	}
+	return result
+}
+
+/*
+	Internal helpers
+*/
+
+func (n *rNode[K]) bounds() Rect {
+	r := n.entries[0].rect
+	for _, e := range n.entries[1:] {
+		r = r.Union(e.rect)
+	}
+	return r
+}
+
+// insert - Add a leaf entry, growing a new root when the old one splits
+func (t *RTree[K]) insert(e rEntry[K]) {
+	if sibling := t.insertAt(t.root, e); sibling != nil {
+		old := t.root
+		t.root = &rNode[K]{entries: []rEntry[K]{
+			{rect: old.bounds(), child: old},
+			{rect: sibling.bounds(), child: sibling},
+		}}
+		t.height++
+	}
+}
+
+// insertAt - Descend to a leaf by least enlargement, return the new sibling of n if it split
+func (t *RTree[K]) insertAt(n *rNode[K], e rEntry[K]) *rNode[K] {
+	if n.leaf {
+		n.entries = append(n.entries, e)
+	} else {
+		i := chooseSubtree(n, e.rect)
+		child := n.entries[i].child
+		sibling := t.insertAt(child, e)
+		n.entries[i].rect = child.bounds()
+		if sibling != nil {
+			n.entries = append(n.entries, rEntry[K]{rect: sibling.bounds(), child: sibling})
+		}
+	}
+
+	if len(n.entries) > t.maxEntries {
+		return t.split(n)
+	}
+	return nil
+}
+
+func chooseSubtree[K comparable](n *rNode[K], r Rect) int {
+	best, bestGrowth, bestArea := 0, math.Inf(1), math.Inf(1)
+	for i, e := range n.entries {
+		area := e.rect.Area()
+		growth := e.rect.Union(r).Area() - area
+		if growth < bestGrowth || (growth == bestGrowth && area < bestArea) {
+			best, bestGrowth, bestArea = i, growth, area
+		}
+	}
+	return best
+}
+
+// split - Quadratic split: seed both groups with the pair wasting the most area,
+// then give each entry to the group it enlarges the least
+func (t *RTree[K]) split(n *rNode[K]) *rNode[K] {
+	entries := n.entries
+	s1, s2, worst := 0, 1, math.Inf(-1)
+	for i := range entries {
+		for j := i + 1; j < len(entries); j++ {
+			waste := entries[i].rect.Union(entries[j].rect).Area() - entries[i].rect.Area() - entries[j].rect.Area()
+			if waste > worst {
+				s1, s2, worst = i, j, waste
+			}
+		}
+	}
+
+	a := []rEntry[K]{entries[s1]}
+	b := []rEntry[K]{entries[s2]}
+	ra, rb := entries[s1].rect, entries[s2].rect
+	rest := make([]rEntry[K], 0, len(entries)-2)
+	for i, e := range entries {
+		if i != s1 && i != s2 {
+			rest = append(rest, e)
+		}
+	}
+
+	for i, e := range rest {
+		// Hand the remaining entries to a group that needs them to reach the minimum
+		if left := len(rest) - i; len(a)+left == t.minEntries {
+			a = append(a, rest[i:]...)
+			break
+		} else if len(b)+left == t.minEntries {
+			b = append(b, rest[i:]...)
+			break
+		}
+
+		growA := ra.Union(e.rect).Area() - ra.Area()
+		growB := rb.Union(e.rect).Area() - rb.Area()
+		if growA < growB || (growA == growB && len(a) <= len(b)) {
+			a, ra = append(a, e), ra.Union(e.rect)
+		} else {
+			b, rb = append(b, e), rb.Union(e.rect)
+		}
+	}
+
+	n.entries = a
+	return &rNode[K]{leaf: n.leaf, entries: b}
+}
+
+// remove - Delete the leaf entry of id, dissolving the underfull nodes on the way back up.
+// Return true if the entry was found below n.
+func (t *RTree[K]) remove(n *rNode[K], id K, r Rect, orphans *[]rEntry[K]) bool {
+	if n.leaf {
+		for i, e := range n.entries {
+			if e.id == id {
+				n.entries = append(n.entries[:i], n.entries[i+1:]...)
+				return true
+			}
+		}
+		return false
+	}
+
+	for i := 0; i < len(n.entries); i++ {
+		e := n.entries[i]
+		if !e.rect.ContainsRect(r) || !t.remove(e.child, id, r, orphans) {
+			continue
+		}
+		if len(e.child.entries) < t.minEntries {
+			collect(e.child, orphans)
+			n.entries = append(n.entries[:i], n.entries[i+1:]...)
+		} else {
+			n.entries[i].rect = e.child.bounds()
+		}
+		return true
+	}
+	return false
+}
+
+// collect - Gather the leaf entries below a node
+func collect[K comparable](n *rNode[K], entries *[]rEntry[K]) {
+	if n.leaf {
+		*entries = append(*entries, n.entries...)
+		return
+	}
+	for _, e := range n.entries {
+		collect(e.child, entries)
+	}
+}
+
+func (t *RTree[K]) search(n *rNode[K], r Rect, fn func(e rEntry[K])) {
+	for _, e := range n.entries {
+		if !r.Intersects(e.rect) {
+			continue
+		}
+		if n.leaf {
+			fn(e)
+		} else {
+			t.search(e.child, r, fn)
+		}
+	}
+}
+
+// pack - Sort-Tile-Recursive: cut the entries sorted by X into vertical slices,
+// sort each slice by Y and fill the nodes in order
+func (t *RTree[K]) pack(entries []rEntry[K], leaf bool) []*rNode[K] {
+	nodeCount := (len(entries) + t.maxEntries - 1) / t.maxEntries
+	slices := int(math.Ceil(math.Sqrt(float64(nodeCount))))
+	sliceSize := slices * t.maxEntries
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].rect.Center().X < entries[j].rect.Center().X })
+	nodes := make([]*rNode[K], 0, nodeCount)
+	for start := 0; start < len(entries); start += sliceSize {
+		end := start + sliceSize
+		if end > len(entries) {
+			end = len(entries)
+		}
+		slice := entries[start:end]
+		sort.Slice(slice, func(i, j int) bool { return slice[i].rect.Center().Y < slice[j].rect.Center().Y })
+
+		for i := 0; i < len(slice); i += t.maxEntries {
+			j := i + t.maxEntries
+			if j > len(slice) {
+				j = len(slice)
+			}
+			node := &rNode[K]{leaf: leaf, entries: make([]rEntry[K], j-i)}
+			copy(node.entries, slice[i:j])
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// rQueued - Node waiting in the best first search with its distance to the query
+type rQueued[K comparable] struct {
+	node     *rNode[K]
+	distance float64
+}
+
+type rQueue[K comparable] []rQueued[K]
+
+func (q rQueue[K]) Len() int           { return len(q) }
+func (q rQueue[K]) Less(i, j int) bool { return q[i].distance < q[j].distance }
+func (q rQueue[K]) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *rQueue[K]) Push(x any)        { *q = append(*q, x.(rQueued[K])) }
+func (q *rQueue[K]) Pop() any {
+	old := *q
+	last := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return last
+}
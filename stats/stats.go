@@ -0,0 +1,318 @@
+package stats
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+	"sort"
+
+	"github.com/rojack96/treje/common"
+)
+
+// Sample - Sorted snapshot of the elements of a numeric set, summarized without changing the set.
+// NaN elements are kept apart: while a sample holds one, Sum, Mean, Percentile, Variance and Range
+// return NaN as float arithmetic does. WithoutNaN drops them.
+type Sample[T common.Number] struct {
+	// values holds the elements that are not NaN in ascending order
+	values []T
+	nans   int
+}
+
+// New - Create a new sample of values
+func New[T common.Number](values ...T) *Sample[T] {
+	s := &Sample[T]{values: make([]T, 0, len(values))}
+	for _, v := range values {
+		if v != v {
+			s.nans++
+			continue
+		}
+		s.values = append(s.values, v)
+	}
+	sort.Slice(s.values, func(i, j int) bool { return s.values[i] < s.values[j] })
+	return s
+}
+
+// FromSlice - Create a new sample from a slice based set
+func FromSlice[T common.Number, S ~[]T](set S) *Sample[T] {
+	return New(set...)
+}
+
+// FromMapSet - Create a new sample from a map set
+func FromMapSet[T common.Number, S ~map[T]V, V any](set S) *Sample[T] {
+	values := make([]T, 0, len(set))
+	for elem := range set {
+		values = append(values, elem)
+	}
+	return New(values...)
+}
+
+/*
+	Summary methods
+*/
+
+// Sum - Return the exact sum in the element type, raise an error if it overflows.
+// Integers are added in 128 bits, so only the final result is checked.
+// Floats are added with compensation and follow IEEE rules: an overflow gives an infinity.
+func (s *Sample[T]) Sum() (T, error) {
+	if isFloat[T]() {
+		return T(s.SumFloat()), nil
+	}
+
+	var hi, lo, carry uint64
+	if isSigned[T]() {
+		for _, v := range s.values {
+			x := int64(v)
+			lo, carry = bits.Add64(lo, uint64(x), 0)
+			// The high word of a negative value is all ones
+			hi, _ = bits.Add64(hi, uint64(x>>63), carry)
+		}
+		total := T(int64(lo))
+		if hi != uint64(int64(lo)>>63) || int64(total) != int64(lo) {
+			return 0, errors.New(common.Overflow)
+		}
+		return total, nil
+	}
+
+	for _, v := range s.values {
+		lo, carry = bits.Add64(lo, uint64(v), 0)
+		hi += carry
+	}
+	total := T(lo)
+	if hi != 0 || uint64(total) != lo {
+		return 0, errors.New(common.Overflow)
+	}
+	return total, nil
+}
+
+// SumFloat - Return the sum as a float64, added with Neumaier compensation
+func (s *Sample[T]) SumFloat() float64 {
+	if s.nans > 0 {
+		return math.NaN()
+	}
+	var total, compensation float64
+	for _, v := range s.values {
+		x := float64(v)
+		t := total + x
+		// Keep the low order bits lost by the addition
+		if math.Abs(total) >= math.Abs(x) {
+			compensation += (total - t) + x
+		} else {
+			compensation += (x - t) + total
+		}
+		total = t
+	}
+	if math.IsInf(total, 0) {
+		return total
+	}
+	return total + compensation
+}
+
+// Mean - Return the arithmetic mean
+func (s *Sample[T]) Mean() (float64, error) {
+	if s.IsEmpty() {
+		return 0, errors.New(common.EmptySet)
+	}
+	return s.SumFloat() / float64(len(s.values)), nil
+}
+
+// Median - Return the middle value, the mean of the two middle values for an even length
+func (s *Sample[T]) Median() (float64, error) {
+	return s.Quantile(0.5)
+}
+
+// Percentile - Return the value below which p percent of the values fall, p in [0, 100]
+func (s *Sample[T]) Percentile(p float64) (float64, error) {
+	if !(p >= 0 && p <= 100) {
+		return 0, errors.New(common.InvalidQuantile)
+	}
+	return s.Quantile(p / 100)
+}
+
+// Quantile - Return the q-quantile, q in [0, 1], interpolating linearly between the closest ranks
+func (s *Sample[T]) Quantile(q float64) (float64, error) {
+	switch {
+	case !(q >= 0 && q <= 1):
+		return 0, errors.New(common.InvalidQuantile)
+	case s.IsEmpty():
+		return 0, errors.New(common.EmptySet)
+	case s.nans > 0:
+		return math.NaN(), nil
+	}
+
+	h := q * float64(len(s.values)-1)
+	i := int(h)
+	if i == len(s.values)-1 {
+		return float64(s.values[i]), nil
+	}
+	lo, hi := float64(s.values[i]), float64(s.values[i+1])
+	return lo + (h-float64(i))*(hi-lo), nil
+}
+
+// Variance - Return the population variance, the mean squared distance to the mean
+func (s *Sample[T]) Variance() (float64, error) {
+	if s.IsEmpty() {
+		return 0, errors.New(common.EmptySet)
+	}
+	return s.squares() / float64(len(s.values)), nil
+}
+
+// StdDev - Return the population standard deviation
+func (s *Sample[T]) StdDev() (float64, error) {
+	v, err := s.Variance()
+	return math.Sqrt(v), err
+}
+
+// SampleVariance - Return the unbiased variance of a sample, dividing by n-1
+func (s *Sample[T]) SampleVariance() (float64, error) {
+	if s.Len() < 2 {
+		return 0, errors.New(common.InvalidSize)
+	}
+	return s.squares() / float64(len(s.values)-1), nil
+}
+
+// SampleStdDev - Return the standard deviation of a sample, dividing by n-1
+func (s *Sample[T]) SampleStdDev() (float64, error) {
+	v, err := s.SampleVariance()
+	return math.Sqrt(v), err
+}
+
+// Range - Return the difference between the largest and the smallest value
+func (s *Sample[T]) Range() (float64, error) {
+	switch {
+	case s.IsEmpty():
+		return 0, errors.New(common.EmptySet)
+	case s.nans > 0:
+		return math.NaN(), nil
+	}
+	return float64(s.values[len(s.values)-1]) - float64(s.values[0]), nil
+}
+
+// Min - Return the smallest value, NaN if the sample holds one
+func (s *Sample[T]) Min() (T, error) {
+	switch {
+	case s.IsEmpty():
+		return 0, errors.New(common.EmptySet)
+	case s.nans > 0:
+		return nan[T](), nil
+	}
+	return s.values[0], nil
+}
+
+// Max - Return the largest value, NaN if the sample holds one
+func (s *Sample[T]) Max() (T, error) {
+	switch {
+	case s.IsEmpty():
+		return 0, errors.New(common.EmptySet)
+	case s.nans > 0:
+		return nan[T](), nil
+	}
+	return s.values[len(s.values)-1], nil
+}
+
+/*
+	Histogram
+*/
+
+// Bin - Values counted in [Lo, Hi), the last bin also holds Hi
+type Bin struct {
+	Lo, Hi float64
+	Count  int
+}
+
+// Histogram - Count the values in bins of equal width between the smallest and the largest value.
+// NaN values are not counted.
+func (s *Sample[T]) Histogram(bins int) ([]Bin, error) {
+	if bins < 1 {
+		return nil, errors.New(common.InvalidBins)
+	}
+	if len(s.values) == 0 {
+		return nil, errors.New(common.EmptySet)
+	}
+	lo, hi := float64(s.values[0]), float64(s.values[len(s.values)-1])
+	if math.IsInf(lo, 0) || math.IsInf(hi, 0) {
+		return nil, errors.New(common.InvalidRange)
+	}
+
+	width := (hi - lo) / float64(bins)
+	result := make([]Bin, bins)
+	for i := range result {
+		result[i] = Bin{Lo: lo + float64(i)*width, Hi: lo + float64(i+1)*width}
+	}
+	result[bins-1].Hi = hi
+	for _, v := range s.values {
+		i := 0
+		if width > 0 {
+			i = int((float64(v) - lo) / width)
+		}
+		if i >= bins {
+			i = bins - 1
+		}
+		result[i].Count++
+	}
+	return result, nil
+}
+
+/*
+	Utility methods
+*/
+
+// Len - Return the number of values, NaN included
+func (s *Sample[T]) Len() int {
+	return len(s.values) + s.nans
+}
+
+// IsEmpty - Return true if the sample holds no value, else false
+func (s *Sample[T]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+// NaNs - Return the number of NaN values
+func (s *Sample[T]) NaNs() int {
+	return s.nans
+}
+
+// WithoutNaN - Return the sample of the values that are not NaN
+func (s *Sample[T]) WithoutNaN() *Sample[T] {
+	return &Sample[T]{values: s.values}
+}
+
+// Values - Return the values that are not NaN in ascending order
+func (s *Sample[T]) Values() []T {
+	values := make([]T, len(s.values))
+	copy(values, s.values)
+	return values
+}
+
+/*
+	Internal helpers
+*/
+
+// squares - Return the sum of the squared distances to the mean, NaN if the sample holds one
+func (s *Sample[T]) squares() float64 {
+	if s.nans > 0 {
+		return math.NaN()
+	}
+	mean := s.SumFloat() / float64(len(s.values))
+	// The second sum corrects the rounding error of the mean
+	var total, drift float64
+	for _, v := range s.values {
+		d := float64(v) - mean
+		total += d * d
+		drift += d
+	}
+	return total - drift*drift/float64(len(s.values))
+}
+
+func isFloat[T common.Number]() bool {
+	half := 0.5
+	return T(half) != 0
+}
+
+func isSigned[T common.Number]() bool {
+	var zero T
+	return zero-1 < zero
+}
+
+func nan[T common.Number]() T {
+	return T(math.NaN())
+}
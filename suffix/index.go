@@ -0,0 +1,147 @@
+package suffix
+
+import (
+	"sort"
+
+	mtype "github.com/rojack96/treje/mapset/types"
+	stype "github.com/rojack96/treje/set/types"
+)
+
+// Index - Generalized suffix array over a set of strings, finds the members containing a pattern.
+// Members are joined with a separator symbol of their own, so no match crosses two members.
+type Index struct {
+	members []string
+	// starts[j] is the offset of members[j] in the joined text
+	starts []int
+	core
+}
+
+// NewIndex - Build the index of members, duplicates are ignored
+func NewIndex(members ...string) *Index {
+	unique := make([]string, 0, len(members))
+	seen := make(map[string]bool, len(members))
+	for _, m := range members {
+		if !seen[m] {
+			seen[m] = true
+			unique = append(unique, m)
+		}
+	}
+	sort.Strings(unique)
+
+	n, total := len(unique), 0
+	for _, m := range unique {
+		total += len(m) + 1
+	}
+	idx := &Index{members: unique, starts: make([]int, n)}
+	codes := make([]int, 0, total)
+	for j, m := range unique {
+		idx.starts[j] = len(codes)
+		for i := 0; i < len(m); i++ {
+			codes = append(codes, int(m[i])+n+1)
+		}
+		// Member j ends with the separator j+1, below every byte
+		codes = append(codes, j+1)
+	}
+	idx.core = build(codes, 256+n+1)
+	return idx
+}
+
+// FromSet - Build the index of a slice-backed StringSet
+func FromSet(s stype.StringSet) *Index {
+	members := make([]string, len(s))
+	for i, e := range s {
+		members[i] = string(e)
+	}
+	return NewIndex(members...)
+}
+
+// FromMapSet - Build the index of a map-backed StringSet
+func FromMapSet(s mtype.StringSet) *Index {
+	members := make([]string, 0, len(s))
+	for e := range s {
+		members = append(members, e)
+	}
+	return NewIndex(members...)
+}
+
+/*
+	Lookup methods
+*/
+
+// Search - Return the members containing pattern in lexicographic order
+func (idx *Index) Search(pattern string) []string {
+	found := idx.matches(pattern)
+	result := make([]string, 0, len(found))
+	for j, ok := range found {
+		if ok {
+			result = append(result, idx.members[j])
+		}
+	}
+	return result
+}
+
+// SearchSet - Return the members containing pattern as a slice-backed StringSet
+func (idx *Index) SearchSet(pattern string) stype.StringSet {
+	members := idx.Search(pattern)
+	result := make(stype.StringSet, len(members))
+	for i, e := range members {
+		result[i] = stype.Str(e)
+	}
+	return result
+}
+
+// SearchMapSet - Return the members containing pattern as a map-backed StringSet
+func (idx *Index) SearchMapSet(pattern string) mtype.StringSet {
+	result, _ := mtype.MapSet{}.String(idx.Search(pattern)...)
+	return result
+}
+
+// Contains - Return true if at least one member contains pattern, else false
+func (idx *Index) Contains(pattern string) bool {
+	lo, hi := idx.lookup(idx.encode(pattern))
+	return lo < hi
+}
+
+// Members - Return the indexed strings in lexicographic order
+func (idx *Index) Members() []string {
+	members := make([]string, len(idx.members))
+	copy(members, idx.members)
+	return members
+}
+
+// Len - Return the number of members
+func (idx *Index) Len() int {
+	return len(idx.members)
+}
+
+// IsEmpty - Return true if the index holds no member, else false
+func (idx *Index) IsEmpty() bool {
+	return len(idx.members) == 0
+}
+
+// matches - Flag the members holding an occurrence of pattern
+func (idx *Index) matches(pattern string) []bool {
+	found := make([]bool, len(idx.members))
+	if pattern == "" {
+		for j := range found {
+			found[j] = true
+		}
+		return found
+	}
+	lo, hi := idx.lookup(idx.encode(pattern))
+	for _, p := range idx.sa[lo:hi] {
+		// The member holding p is the last one starting at or before it
+		j := sort.Search(len(idx.starts), func(j int) bool { return idx.starts[j] > p }) - 1
+		found[j] = true
+	}
+	return found
+}
+
+func (idx *Index) encode(pattern string) []int {
+	n := len(idx.members)
+	codes := make([]int, len(pattern))
+	for i := 0; i < len(pattern); i++ {
+		codes[i] = int(pattern[i]) + n + 1
+	}
+	return codes
+}
@@ -0,0 +1,203 @@
+package suffix
+
+import "sort"
+
+// core - Suffix array and LCP array of a sequence of symbols
+type core struct {
+	codes []int
+	sa    []int
+	// lcp[i] is the length of the common prefix of the suffixes sa[i-1] and sa[i], lcp[0] is 0
+	lcp []int
+}
+
+// build - Index codes whose symbols are in [1, k)
+func build(codes []int, k int) core {
+	s := make([]int, len(codes)+1)
+	copy(s, codes)
+	// The sentinel 0 ends the sequence, it is the unique smallest symbol and sorts first
+	sa := sais(s, k)[1:]
+	return core{codes: codes, sa: sa, lcp: kasai(codes, sa)}
+}
+
+// lookup - Return the range of sa whose suffixes start with pattern
+func (c *core) lookup(pattern []int) (int, int) {
+	lo := sort.Search(len(c.sa), func(i int) bool { return c.compare(c.sa[i], pattern) >= 0 })
+	hi := sort.Search(len(c.sa), func(i int) bool { return c.compare(c.sa[i], pattern) > 0 })
+	return lo, hi
+}
+
+// compare - Compare the suffix at p with pattern, 0 when the suffix starts with pattern
+func (c *core) compare(p int, pattern []int) int {
+	for d, symbol := range pattern {
+		switch {
+		case p+d >= len(c.codes):
+			return -1
+		case c.codes[p+d] < symbol:
+			return -1
+		case c.codes[p+d] > symbol:
+			return 1
+		}
+	}
+	return 0
+}
+
+// sais - Suffix array by induced sorting (Nong, Zhang and Chan), linear in len(s).
+// s must end with a unique smallest symbol 0 and use symbols in [0, k).
+func sais(s []int, k int) []int {
+	n := len(s)
+	sa := make([]int, n)
+	if n == 1 {
+		return sa
+	}
+
+	// stype[i] is true when the suffix at i is smaller than the one at i+1
+	stype := make([]bool, n)
+	stype[n-1] = true
+	for i := n - 2; i >= 0; i-- {
+		stype[i] = s[i] < s[i+1] || (s[i] == s[i+1] && stype[i+1])
+	}
+	isLMS := func(i int) bool { return i > 0 && stype[i] && !stype[i-1] }
+
+	// Sort the LMS substrings by inducing from their unsorted positions
+	for i := range sa {
+		sa[i] = -1
+	}
+	ends := buckets(s, k, true)
+	for i := 1; i < n; i++ {
+		if isLMS(i) {
+			ends[s[i]]--
+			sa[ends[s[i]]] = i
+		}
+	}
+	induce(sa, s, stype, k)
+
+	m := 0
+	for _, p := range sa {
+		if isLMS(p) {
+			sa[m] = p
+			m++
+		}
+	}
+	for i := m; i < n; i++ {
+		sa[i] = -1
+	}
+
+	// Name the LMS substrings, equal substrings share a name
+	name, prev := 0, -1
+	for i := 0; i < m; i++ {
+		p := sa[i]
+		differs := prev < 0
+		for d := 0; !differs; d++ {
+			if s[p+d] != s[prev+d] || stype[p+d] != stype[prev+d] {
+				differs = true
+			} else if d > 0 && (isLMS(p+d) || isLMS(prev+d)) {
+				break
+			}
+		}
+		if differs {
+			name++
+			prev = p
+		}
+		// LMS positions are at least two apart, so p/2 is a free slot
+		sa[m+p/2] = name - 1
+	}
+	reduced := make([]int, 0, m)
+	for i := m; i < n; i++ {
+		if sa[i] >= 0 {
+			reduced = append(reduced, sa[i])
+		}
+	}
+
+	// Sort the LMS suffixes, recursing while some names repeat
+	var order []int
+	if name < m {
+		order = sais(reduced, name)
+	} else {
+		order = make([]int, m)
+		for i, r := range reduced {
+			order[r] = i
+		}
+	}
+	positions := make([]int, 0, m)
+	for i := 1; i < n; i++ {
+		if isLMS(i) {
+			positions = append(positions, i)
+		}
+	}
+
+	// Induce the whole array from the sorted LMS suffixes
+	for i := range sa {
+		sa[i] = -1
+	}
+	ends = buckets(s, k, true)
+	for i := m - 1; i >= 0; i-- {
+		p := positions[order[i]]
+		ends[s[p]]--
+		sa[ends[s[p]]] = p
+	}
+	induce(sa, s, stype, k)
+	return sa
+}
+
+// induce - Place the L suffixes left to right, then the S suffixes right to left
+func induce(sa, s []int, stype []bool, k int) {
+	starts := buckets(s, k, false)
+	for i := 0; i < len(sa); i++ {
+		if j := sa[i] - 1; j >= 0 && !stype[j] {
+			sa[starts[s[j]]] = j
+			starts[s[j]]++
+		}
+	}
+	ends := buckets(s, k, true)
+	for i := len(sa) - 1; i >= 0; i-- {
+		if j := sa[i] - 1; j >= 0 && stype[j] {
+			ends[s[j]]--
+			sa[ends[s[j]]] = j
+		}
+	}
+}
+
+// buckets - Return the start, or the end, of the bucket of each symbol
+func buckets(s []int, k int, end bool) []int {
+	bkt := make([]int, k)
+	for _, c := range s {
+		bkt[c]++
+	}
+	sum := 0
+	for c := range bkt {
+		sum += bkt[c]
+		if end {
+			bkt[c] = sum
+		} else {
+			bkt[c] = sum - bkt[c]
+		}
+	}
+	return bkt
+}
+
+// kasai - LCP array in linear time from the suffix array
+func kasai(codes, sa []int) []int {
+	n := len(codes)
+	rank := make([]int, n)
+	for i, p := range sa {
+		rank[p] = i
+	}
+
+	lcp := make([]int, n)
+	h := 0
+	for p := 0; p < n; p++ {
+		if rank[p] == 0 {
+			h = 0
+			continue
+		}
+		q := sa[rank[p]-1]
+		for p+h < n && q+h < n && codes[p+h] == codes[q+h] {
+			h++
+		}
+		lcp[rank[p]] = h
+		if h > 0 {
+			h--
+		}
+	}
+	return lcp
+}
@@ -0,0 +1,97 @@
+package suffix
+
+import "sort"
+
+// SuffixArray - Sorted suffixes of a text with their LCP array, built in linear time with SA-IS.
+// Positions are byte offsets.
+type SuffixArray struct {
+	text string
+	core
+}
+
+// New - Build the suffix array of text
+func New(text string) *SuffixArray {
+	codes := make([]int, len(text))
+	for i := 0; i < len(text); i++ {
+		// Bytes are shifted by one, 0 is the sentinel
+		codes[i] = int(text[i]) + 1
+	}
+	return &SuffixArray{text: text, core: build(codes, 257)}
+}
+
+/*
+	Lookup methods
+*/
+
+// Find - Return the positions of every occurrence of pattern in increasing order.
+// An empty pattern occurs at every position.
+func (s *SuffixArray) Find(pattern string) []int {
+	lo, hi := s.lookup(s.encode(pattern))
+	positions := make([]int, hi-lo)
+	copy(positions, s.sa[lo:hi])
+	sort.Ints(positions)
+	return positions
+}
+
+// Count - Return the number of occurrences of pattern
+func (s *SuffixArray) Count(pattern string) int {
+	lo, hi := s.lookup(s.encode(pattern))
+	return hi - lo
+}
+
+// Contains - Return true if pattern is in the text, else false
+func (s *SuffixArray) Contains(pattern string) bool {
+	return s.Count(pattern) > 0
+}
+
+// LongestRepeated - Return the longest substring occurring at least twice, occurrences may overlap.
+// The leftmost one in suffix order is returned on ties, "" if no byte repeats.
+func (s *SuffixArray) LongestRepeated() string {
+	best, length := 0, 0
+	for i, l := range s.lcp {
+		if l > length {
+			best, length = i, l
+		}
+	}
+	if length == 0 {
+		return ""
+	}
+	return s.text[s.sa[best] : s.sa[best]+length]
+}
+
+// Len - Return the length of the text
+func (s *SuffixArray) Len() int {
+	return len(s.text)
+}
+
+// Text - Return the indexed text
+func (s *SuffixArray) Text() string {
+	return s.text
+}
+
+/*
+	Conversion methods
+*/
+
+// Array - Return the start positions of the suffixes in lexicographic order
+func (s *SuffixArray) Array() []int {
+	sa := make([]int, len(s.sa))
+	copy(sa, s.sa)
+	return sa
+}
+
+// LCP - Return the LCP array, the i-th value is the length of the longest common prefix
+// of the suffixes at Array()[i-1] and Array()[i], the first one is 0
+func (s *SuffixArray) LCP() []int {
+	lcp := make([]int, len(s.lcp))
+	copy(lcp, s.lcp)
+	return lcp
+}
+
+func (s *SuffixArray) encode(pattern string) []int {
+	codes := make([]int, len(pattern))
+	for i := 0; i < len(pattern); i++ {
+		codes[i] = int(pattern[i]) + 1
+	}
+	return codes
+}
@@ -0,0 +1,227 @@
+package topk
+
+import (
+	"container/heap"
+	"errors"
+	"sort"
+
+	"github.com/rojack96/treje/common"
+	"github.com/rojack96/treje/mapset/types"
+)
+
+// Item - Tracked element with its estimated count.
+// The true count lies between Count-Error and Count.
+type Item[T comparable] struct {
+	Elem  T
+	Count uint64
+	Error uint64
+}
+
+// TopK - Space-Saving tracker of the heaviest hitters of a stream using k counters.
+// Every element occurring more than Total/k times is guaranteed to be tracked.
+type TopK[T comparable] struct {
+	k     int
+	items map[T]*counter[T]
+	heap  counters[T]
+	total uint64
+}
+
+// New - Create a tracker keeping k counters
+func New[T comparable](k int) (*TopK[T], error) {
+	if k < 1 {
+		return nil, errors.New(common.InvalidCapacity)
+	}
+	return &TopK[T]{k: k, items: make(map[T]*counter[T], k)}, nil
+}
+
+/*
+	Manipulation methods
+*/
+
+// Add - Record one occurrence of an element
+func (t *TopK[T]) Add(elem T) {
+	t.AddCount(elem, 1)
+}
+
+// AddCount - Record n occurrences of an element.
+// When every counter is taken the least counted element is replaced and its count inherited.
+func (t *TopK[T]) AddCount(elem T, n uint64) {
+	if n == 0 {
+		return
+	}
+	t.total += n
+
+	if c, ok := t.items[elem]; ok {
+		c.Count += n
+		heap.Fix(&t.heap, c.index)
+		return
+	}
+
+	if len(t.heap) < t.k {
+		c := &counter[T]{Item: Item[T]{Elem: elem, Count: n}}
+		heap.Push(&t.heap, c)
+		t.items[elem] = c
+		return
+	}
+
+	c := t.heap[0]
+	delete(t.items, c.Elem)
+	c.Elem, c.Error, c.Count = elem, c.Count, c.Count+n
+	heap.Fix(&t.heap, 0)
+	t.items[elem] = c
+}
+
+// Clear - Forget every occurrence
+func (t *TopK[T]) Clear() {
+	t.items = make(map[T]*counter[T], t.k)
+	t.heap = nil
+	t.total = 0
+}
+
+/*
+	Utility methods
+*/
+
+// Estimate - Return the tracked item of an element, false if it is not tracked
+func (t *TopK[T]) Estimate(elem T) (Item[T], bool) {
+	if c, ok := t.items[elem]; ok {
+		return c.Item, true
+	}
+	return Item[T]{}, false
+}
+
+// Has - Return true if the element is tracked, else false
+func (t *TopK[T]) Has(elem T) bool {
+	_, ok := t.items[elem]
+	return ok
+}
+
+// Top - Return the n heaviest tracked items sorted by decreasing count, all of them if n exceeds Len
+func (t *TopK[T]) Top(n int) []Item[T] {
+	items := t.ToSlice()
+	if n < len(items) {
+		items = items[:n]
+	}
+	return items
+}
+
+// Guaranteed - Return the longest prefix of the sorted items known to be the true heaviest hitters,
+// that is whose lowest possible counts beat the highest possible count of every other element
+func (t *TopK[T]) Guaranteed() []Item[T] {
+	items := t.ToSlice()
+	if len(items) == 0 {
+		return items
+	}
+
+	// An untracked element occurred at most as often as the smallest counter when all are taken
+	var untracked uint64
+	if len(items) == t.k {
+		untracked = items[len(items)-1].Count
+	}
+
+	best, lowest := 0, items[0].Count-items[0].Error
+	for p := 1; p <= len(items); p++ {
+		if low := items[p-1].Count - items[p-1].Error; low < lowest {
+			lowest = low
+		}
+		next := untracked
+		if p < len(items) {
+			next = items[p].Count
+		}
+		if lowest >= next {
+			best = p
+		}
+	}
+	return items[:best]
+}
+
+// Len - Return the number of tracked elements
+func (t *TopK[T]) Len() int {
+	return len(t.heap)
+}
+
+// K - Return the number of counters
+func (t *TopK[T]) K() int {
+	return t.k
+}
+
+// Total - Return the number of recorded occurrences
+func (t *TopK[T]) Total() uint64 {
+	return t.total
+}
+
+// IsEmpty - Return true if nothing was recorded, else false
+func (t *TopK[T]) IsEmpty() bool {
+	return t.total == 0
+}
+
+/*
+	Methods to manipulate a tracker object
+*/
+
+// ToSlice - Return every tracked item sorted by decreasing count, ties by increasing error
+func (t *TopK[T]) ToSlice() []Item[T] {
+	items := make([]Item[T], len(t.heap))
+	for i, c := range t.heap {
+		items[i] = c.Item
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Count != items[j].Count {
+			return items[i].Count > items[j].Count
+		}
+		return items[i].Error < items[j].Error
+	})
+	return items
+}
+
+// ToMapSet - Return the tracked elements as a set
+func (t *TopK[T]) ToMapSet() types.ComparableSet[T] {
+	set := make(types.ComparableSet[T], len(t.items))
+	for elem := range t.items {
+		set.Add(elem)
+	}
+	return set
+}
+
+// Copy - Return a deep copy of the tracker
+func (t *TopK[T]) Copy() *TopK[T] {
+	c := &TopK[T]{k: t.k, items: make(map[T]*counter[T], t.k), heap: make(counters[T], len(t.heap)), total: t.total}
+	for i, item := range t.heap {
+		dup := *item
+		c.heap[i] = &dup
+		c.items[dup.Elem] = &dup
+	}
+	return c
+}
+
+/*
+	Internal helpers
+*/
+
+type counter[T comparable] struct {
+	Item[T]
+	index int
+}
+
+// counters - Min heap of the counters on their count
+type counters[T comparable] []*counter[T]
+
+func (h counters[T]) Len() int           { return len(h) }
+func (h counters[T]) Less(i, j int) bool { return h[i].Count < h[j].Count }
+func (h counters[T]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index, h[j].index = i, j
+}
+
+func (h *counters[T]) Push(x any) {
+	c := x.(*counter[T])
+	c.index = len(*h)
+	*h = append(*h, c)
+}
+
+func (h *counters[T]) Pop() any {
+	old := *h
+	c := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return c
+}
@@ -0,0 +1,21 @@
+package tree
+
+// balanceAVL - Restore the AVL invariant on a node whose subtrees differ in height by at most two
+func balanceAVL[K, V any](n *node[K, V]) *node[K, V] {
+	update(n)
+
+	switch diff := height(n.left) - height(n.right); {
+	case diff > 1:
+		if height(n.left.left) < height(n.left.right) {
+			n.left = rotateLeft(n.left)
+		}
+		return rotateRight(n)
+	case diff < -1:
+		if height(n.right.right) < height(n.right.left) {
+			n.right = rotateRight(n.right)
+		}
+		return rotateLeft(n)
+	}
+
+	return n
+}
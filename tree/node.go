@@ -0,0 +1,83 @@
+package tree
+
+type node[K, V any] struct {
+	key    K
+	value  V
+	left   *node[K, V]
+	right  *node[K, V]
+	height int
+	red    bool
+}
+
+func newNode[K, V any](key K, value V) *node[K, V] {
+	return &node[K, V]{key: key, value: value, height: 1, red: true}
+}
+
+func height[K, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func isRed[K, V any](n *node[K, V]) bool {
+	return n != nil && n.red
+}
+
+// update - Recompute the height of a node from its children
+func update[K, V any](n *node[K, V]) {
+	l, r := height(n.left), height(n.right)
+	if l > r {
+		n.height = l + 1
+	} else {
+		n.height = r + 1
+	}
+}
+
+// rotateLeft - Make the right child the new root of the subtree
+func rotateLeft[K, V any](n *node[K, V]) *node[K, V] {
+	x := n.right
+	n.right = x.left
+	x.left = n
+	x.red = n.red
+	n.red = true
+	update(n)
+	update(x)
+	return x
+}
+
+// rotateRight - Make the left child the new root of the subtree
+func rotateRight[K, V any](n *node[K, V]) *node[K, V] {
+	x := n.left
+	n.left = x.right
+	x.right = n
+	x.red = n.red
+	n.red = true
+	update(n)
+	update(x)
+	return x
+}
+
+func minNode[K, V any](n *node[K, V]) *node[K, V] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func maxNode[K, V any](n *node[K, V]) *node[K, V] {
+	for n.right != nil {
+		n = n.right
+	}
+	return n
+}
+
+func clone[K, V any](n *node[K, V]) *node[K, V] {
+	if n == nil {
+		return nil
+	}
+	c := *n
+	c.left = clone(n.left)
+	c.right = clone(n.right)
+	return &c
+}
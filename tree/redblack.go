@@ -0,0 +1,88 @@
+package tree
+
+/*
+	The red-black tree is implemented as a left-leaning red-black tree,
+	where red links always lean left. It keeps the classic red-black
+	invariants while needing a single fix-up routine for insert and delete.
+*/
+
+func flipColors[K, V any](n *node[K, V]) {
+	n.red = !n.red
+	n.left.red = !n.left.red
+	n.right.red = !n.right.red
+}
+
+// balanceRedBlack - Restore the left-leaning invariants on the way up
+func balanceRedBlack[K, V any](n *node[K, V]) *node[K, V] {
+	update(n)
+
+	if isRed(n.right) && !isRed(n.left) {
+		n = rotateLeft(n)
+	}
+	if isRed(n.left) && isRed(n.left.left) {
+		n = rotateRight(n)
+	}
+	if isRed(n.left) && isRed(n.right) {
+		flipColors(n)
+	}
+	return n
+}
+
+func moveRedLeft[K, V any](n *node[K, V]) *node[K, V] {
+	flipColors(n)
+	if isRed(n.right.left) {
+		n.right = rotateRight(n.right)
+		n = rotateLeft(n)
+		flipColors(n)
+	}
+	return n
+}
+
+func moveRedRight[K, V any](n *node[K, V]) *node[K, V] {
+	flipColors(n)
+	if isRed(n.left.left) {
+		n = rotateRight(n)
+		flipColors(n)
+	}
+	return n
+}
+
+func (t *TreeMap[K, V]) deleteMinRedBlack(n *node[K, V]) *node[K, V] {
+	if n.left == nil {
+		return nil
+	}
+	if !isRed(n.left) && !isRed(n.left.left) {
+		n = moveRedLeft(n)
+	}
+	n.left = t.deleteMinRedBlack(n.left)
+	return balanceRedBlack(n)
+}
+
+// deleteRedBlack - Remove a key that is known to be present in the subtree
+func (t *TreeMap[K, V]) deleteRedBlack(n *node[K, V], key K) *node[K, V] {
+	if t.compare(key, n.key) < 0 {
+		if !isRed(n.left) && !isRed(n.left.left) {
+			n = moveRedLeft(n)
+		}
+		n.left = t.deleteRedBlack(n.left, key)
+		return balanceRedBlack(n)
+	}
+
+	if isRed(n.left) {
+		n = rotateRight(n)
+	}
+	if t.compare(key, n.key) == 0 && n.right == nil {
+		return nil
+	}
+	if !isRed(n.right) && !isRed(n.right.left) {
+		n = moveRedRight(n)
+	}
+	if t.compare(key, n.key) == 0 {
+		successor := minNode(n.right)
+		n.key, n.value = successor.key, successor.value
+		n.right = t.deleteMinRedBlack(n.right)
+	} else {
+		n.right = t.deleteRedBlack(n.right, key)
+	}
+	return balanceRedBlack(n)
+}
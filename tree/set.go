@@ -0,0 +1,232 @@
+package tree
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rojack96/treje/common"
+)
+
+type void struct{}
+
+// TreeSet - Ordered set backed by a binary search tree
+type TreeSet[K any] struct {
+	tree *TreeMap[K, void]
+}
+
+// NewSet - Create a new set for ordered elements, optionally filled from a slice
+func NewSet[K common.Ordered](balance Balance, elems ...K) *TreeSet[K] {
+	return NewSetFunc(balance, common.Compare[K], elems...)
+}
+
+// NewSetFunc - Create a new set ordered by the given comparator, optionally filled from a slice
+func NewSetFunc[K any](balance Balance, compare common.Comparator[K], elems ...K) *TreeSet[K] {
+	set := &TreeSet[K]{tree: NewMapFunc[K, void](balance, compare)}
+	for _, e := range elems {
+		set.tree.Put(e, void{})
+	}
+	return set
+}
+
+/*
+	Manipulation set methods
+*/
+
+// Add - Insert a new element to the set if and only if it is not already present
+func (set *TreeSet[K]) Add(elem K) error {
+	if !set.tree.Put(elem, void{}) {
+		return errors.New(fmt.Sprint(elem) + " " + common.AlreadyExists)
+	}
+	return nil
+}
+
+// Remove - Remove a specific element from a set, if the element not exists raise an error
+func (set *TreeSet[K]) Remove(elem K) error {
+	if set.IsEmpty() {
+		return errors.New(common.EmptySet)
+	}
+	if !set.tree.Delete(elem) {
+		return errors.New(common.ElemNotExist)
+	}
+	return nil
+}
+
+// Discard - Remove a specific element from set
+func (set *TreeSet[K]) Discard(elem K) {
+	set.tree.Delete(elem)
+}
+
+// Pop - Remove and return the smallest element
+func (set *TreeSet[K]) Pop() (K, error) {
+	elem, _, ok := set.tree.Min()
+	if !ok {
+		return elem, errors.New(common.EmptySet)
+	}
+	set.tree.Delete(elem)
+	return elem, nil
+}
+
+/*
+	Set operation methods
+
+	The result uses the comparator and balance of the receiver, b must be ordered the same way.
+*/
+
+// Union - Returns a new set with the elements of both sets
+func (set *TreeSet[K]) Union(b *TreeSet[K]) *TreeSet[K] {
+	return set.merge(b, true, true, true)
+}
+
+// Intersect - Returns the elements that are present in both input sets.
+func (set *TreeSet[K]) Intersect(b *TreeSet[K]) *TreeSet[K] {
+	return set.merge(b, false, true, false)
+}
+
+// Difference - Returns the elements that are present in the first set
+// but not in the second set.
+func (set *TreeSet[K]) Difference(b *TreeSet[K]) *TreeSet[K] {
+	return set.merge(b, true, false, false)
+}
+
+// SymmetricDifference - Returns a new set with elements that are present in either of the two sets but not in both.
+func (set *TreeSet[K]) SymmetricDifference(b *TreeSet[K]) *TreeSet[K] {
+	return set.merge(b, true, false, true)
+}
+
+// IsSubsetOf - Returns true if the current set is a subset of the given set b.
+func (set *TreeSet[K]) IsSubsetOf(b *TreeSet[K]) bool {
+	if set.Len() > b.Len() {
+		return false
+	}
+	subset := true
+	set.Each(func(elem K) bool {
+		subset = b.Has(elem)
+		return subset
+	})
+	return subset
+}
+
+// Equals - Returns true if the current set and set b contain the same elements.
+func (set *TreeSet[K]) Equals(b *TreeSet[K]) bool {
+	return set.Len() == b.Len() && set.IsSubsetOf(b)
+}
+
+/*
+	Utility methods
+*/
+
+// Has - Return true if the element is in set, otherwise false
+func (set *TreeSet[K]) Has(elem K) bool {
+	return set.tree.Has(elem)
+}
+
+// Len - Return the number of elements
+func (set *TreeSet[K]) Len() int {
+	return set.tree.Len()
+}
+
+// IsEmpty - Return true if the set is empty, else false
+func (set *TreeSet[K]) IsEmpty() bool {
+	return set.tree.IsEmpty()
+}
+
+// Clear - Remove all elements
+func (set *TreeSet[K]) Clear() {
+	set.tree.Clear()
+}
+
+// Min - Return minimum element from the set
+func (set *TreeSet[K]) Min() (K, error) {
+	elem, _, ok := set.tree.Min()
+	if !ok {
+		return elem, errors.New(common.EmptySet)
+	}
+	return elem, nil
+}
+
+// Max - Return maximum element from the set
+func (set *TreeSet[K]) Max() (K, error) {
+	elem, _, ok := set.tree.Max()
+	if !ok {
+		return elem, errors.New(common.EmptySet)
+	}
+	return elem, nil
+}
+
+// Floor - Return the greatest element less than or equal to elem
+func (set *TreeSet[K]) Floor(elem K) (K, bool) {
+	k, _, ok := set.tree.Floor(elem)
+	return k, ok
+}
+
+// Ceiling - Return the smallest element greater than or equal to elem
+func (set *TreeSet[K]) Ceiling(elem K) (K, bool) {
+	k, _, ok := set.tree.Ceiling(elem)
+	return k, ok
+}
+
+// Lower - Return the greatest element strictly less than elem
+func (set *TreeSet[K]) Lower(elem K) (K, bool) {
+	k, _, ok := set.tree.Lower(elem)
+	return k, ok
+}
+
+// Higher - Return the smallest element strictly greater than elem
+func (set *TreeSet[K]) Higher(elem K) (K, bool) {
+	k, _, ok := set.tree.Higher(elem)
+	return k, ok
+}
+
+// Each - Visit elements in ascending order until fn returns false
+func (set *TreeSet[K]) Each(fn func(elem K) bool) {
+	set.tree.InOrder(func(key K, _ void) bool {
+		return fn(key)
+	})
+}
+
+// Range - Visit elements with lo <= elem < hi in ascending order until fn returns false
+func (set *TreeSet[K]) Range(lo, hi K, fn func(elem K) bool) {
+	set.tree.Range(lo, hi, func(key K, _ void) bool {
+		return fn(key)
+	})
+}
+
+// Height - Return the height of the underlying tree
+func (set *TreeSet[K]) Height() int {
+	return set.tree.Height()
+}
+
+// Validate - Check the invariants of the underlying tree
+func (set *TreeSet[K]) Validate() error {
+	return set.tree.Validate()
+}
+
+/*
+	Methods to manipulate a set object
+*/
+
+// Copy - Return a deep copy of the set
+func (set *TreeSet[K]) Copy() (*TreeSet[K], error) {
+	if set.IsEmpty() {
+		return nil, errors.New(common.CopyEmpty)
+	}
+	return &TreeSet[K]{tree: set.tree.Copy()}, nil
+}
+
+// ToSlice - Returns a sorted slice of the elements
+func (set *TreeSet[K]) ToSlice() ([]K, error) {
+	if set.IsEmpty() {
+		return nil, errors.New(common.EmptySet)
+	}
+	return set.tree.Keys(), nil
+}
+
+// merge - Walk both sorted sets once, keeping elements only in set (onlyA),
+// in both (both) or only in b (onlyB)
+func (set *TreeSet[K]) merge(b *TreeSet[K], onlyA, both, onlyB bool) *TreeSet[K] {
+	result := &TreeSet[K]{tree: NewMapFunc[K, void](set.tree.balance, set.tree.compare)}
+	common.MergeSorted(set.tree.iterator(), b.tree.iterator(), set.tree.compare, onlyA, both, onlyB, func(elem K) {
+		result.tree.Put(elem, void{})
+	})
+	return result
+}
@@ -0,0 +1,103 @@
+package tree
+
+import "github.com/rojack96/treje/common"
+
+/*
+	Traversal methods
+
+	Every traversal calls fn once per entry and stops as soon as fn returns false.
+*/
+
+// InOrder - Visit entries in ascending key order
+func (t *TreeMap[K, V]) InOrder(fn func(key K, value V) bool) {
+	inOrder(t.root, fn)
+}
+
+// ReverseOrder - Visit entries in descending key order
+func (t *TreeMap[K, V]) ReverseOrder(fn func(key K, value V) bool) {
+	reverseOrder(t.root, fn)
+}
+
+// PreOrder - Visit each node before its children
+func (t *TreeMap[K, V]) PreOrder(fn func(key K, value V) bool) {
+	preOrder(t.root, fn)
+}
+
+// PostOrder - Visit each node after its children
+func (t *TreeMap[K, V]) PostOrder(fn func(key K, value V) bool) {
+	postOrder(t.root, fn)
+}
+
+// Range - Visit entries with lo <= key < hi in ascending order
+func (t *TreeMap[K, V]) Range(lo, hi K, fn func(key K, value V) bool) {
+	t.rangeFrom(t.root, lo, hi, fn)
+}
+
+// iterator - Return a pull iterator over the keys in ascending order
+func (t *TreeMap[K, V]) iterator() common.Iterator[K] {
+	// stack holds the nodes whose left subtree is being visited
+	var stack []*node[K, V]
+	for n := t.root; n != nil; n = n.left {
+		stack = append(stack, n)
+	}
+	return func() (K, bool) {
+		if len(stack) == 0 {
+			var zero K
+			return zero, false
+		}
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for c := n.right; c != nil; c = c.left {
+			stack = append(stack, c)
+		}
+		return n.key, true
+	}
+}
+
+func inOrder[K, V any](n *node[K, V], fn func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return inOrder(n.left, fn) && fn(n.key, n.value) && inOrder(n.right, fn)
+}
+
+func reverseOrder[K, V any](n *node[K, V], fn func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return reverseOrder(n.right, fn) && fn(n.key, n.value) && reverseOrder(n.left, fn)
+}
+
+func preOrder[K, V any](n *node[K, V], fn func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return fn(n.key, n.value) && preOrder(n.left, fn) && preOrder(n.right, fn)
+}
+
+func postOrder[K, V any](n *node[K, V], fn func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return postOrder(n.left, fn) && postOrder(n.right, fn) && fn(n.key, n.value)
+}
+
+func (t *TreeMap[K, V]) rangeFrom(n *node[K, V], lo, hi K, fn func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	aboveLo := t.compare(n.key, lo) >= 0
+	belowHi := t.compare(n.key, hi) < 0
+
+	if aboveLo && !t.rangeFrom(n.left, lo, hi, fn) {
+		return false
+	}
+	if aboveLo && belowHi && !fn(n.key, n.value) {
+		return false
+	}
+	if belowHi {
+		return t.rangeFrom(n.right, lo, hi, fn)
+	}
+	return true
+}
@@ -0,0 +1,319 @@
+package tree
+
+import (
+	"errors"
+
+	"github.com/rojack96/treje/common"
+)
+
+// Balance - Strategy used to keep the tree balanced
+type Balance int
+
+const (
+	// BST - Plain binary search tree, no rebalancing
+	BST Balance = iota
+	// AVL - Height-balanced tree, faster lookups
+	AVL
+	// RedBlack - Left-leaning red-black tree, fewer rotations on updates
+	RedBlack
+)
+
+// TreeMap - Ordered map backed by a binary search tree
+type TreeMap[K, V any] struct {
+	root    *node[K, V]
+	size    int
+	compare common.Comparator[K]
+	balance Balance
+}
+
+// NewMap - Create a new empty tree map for ordered keys
+func NewMap[K common.Ordered, V any](balance Balance) *TreeMap[K, V] {
+	return NewMapFunc[K, V](balance, common.Compare[K])
+}
+
+// NewMapFunc - Create a new empty tree map ordered by the given comparator
+func NewMapFunc[K, V any](balance Balance, compare common.Comparator[K]) *TreeMap[K, V] {
+	return &TreeMap[K, V]{compare: compare, balance: balance}
+}
+
+/*
+	Manipulation map methods
+*/
+
+// Put - Insert or replace the value of a key, return true if the key is new
+func (t *TreeMap[K, V]) Put(key K, value V) bool {
+	inserted := false
+	t.root = t.put(t.root, key, value, &inserted)
+	if t.balance == RedBlack {
+		t.root.red = false
+	}
+	if inserted {
+		t.size++
+	}
+	return inserted
+}
+
+// Delete - Remove a key, return true if the key was present
+func (t *TreeMap[K, V]) Delete(key K) bool {
+	if !t.Has(key) {
+		return false
+	}
+
+	if t.balance == RedBlack {
+		if !isRed(t.root.left) && !isRed(t.root.right) {
+			t.root.red = true
+		}
+		t.root = t.deleteRedBlack(t.root, key)
+		if t.root != nil {
+			t.root.red = false
+		}
+	} else {
+		t.root = t.delete(t.root, key)
+	}
+
+	t.size--
+	return true
+}
+
+// Remove - Remove a key, if the key not exists raise an error
+func (t *TreeMap[K, V]) Remove(key K) error {
+	if t.IsEmpty() {
+		return errors.New(common.EmptySet)
+	}
+	if !t.Delete(key) {
+		return errors.New(common.KeyNotExist)
+	}
+	return nil
+}
+
+// Clear - Remove all entries
+func (t *TreeMap[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+/*
+	Lookup methods
+*/
+
+// Get - Return the value of a key and true if the key is present
+func (t *TreeMap[K, V]) Get(key K) (V, bool) {
+	if n := t.find(key); n != nil {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Has - Return true if the key is in the map, otherwise false
+func (t *TreeMap[K, V]) Has(key K) bool {
+	return t.find(key) != nil
+}
+
+// Len - Return the number of entries
+func (t *TreeMap[K, V]) Len() int {
+	return t.size
+}
+
+// IsEmpty - Return true if the map is empty, else false
+func (t *TreeMap[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Min - Return the entry with the smallest key
+func (t *TreeMap[K, V]) Min() (K, V, bool) {
+	if t.root == nil {
+		return t.none()
+	}
+	n := minNode(t.root)
+	return n.key, n.value, true
+}
+
+// Max - Return the entry with the greatest key
+func (t *TreeMap[K, V]) Max() (K, V, bool) {
+	if t.root == nil {
+		return t.none()
+	}
+	n := maxNode(t.root)
+	return n.key, n.value, true
+}
+
+// Floor - Return the entry with the greatest key less than or equal to key
+func (t *TreeMap[K, V]) Floor(key K) (K, V, bool) {
+	return t.seek(key, false, true)
+}
+
+// Ceiling - Return the entry with the smallest key greater than or equal to key
+func (t *TreeMap[K, V]) Ceiling(key K) (K, V, bool) {
+	return t.seek(key, true, true)
+}
+
+// Lower - Return the entry with the greatest key strictly less than key
+func (t *TreeMap[K, V]) Lower(key K) (K, V, bool) {
+	return t.seek(key, false, false)
+}
+
+// Higher - Return the entry with the smallest key strictly greater than key
+func (t *TreeMap[K, V]) Higher(key K) (K, V, bool) {
+	return t.seek(key, true, false)
+}
+
+/*
+	Methods to manipulate a map object
+*/
+
+// Keys - Return all keys in ascending order
+func (t *TreeMap[K, V]) Keys() []K {
+	keys := make([]K, 0, t.size)
+	t.InOrder(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Values - Return all values ordered by key
+func (t *TreeMap[K, V]) Values() []V {
+	values := make([]V, 0, t.size)
+	t.InOrder(func(_ K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+// Copy - Return a deep copy of the map sharing the same comparator and balance
+func (t *TreeMap[K, V]) Copy() *TreeMap[K, V] {
+	return &TreeMap[K, V]{root: clone(t.root), size: t.size, compare: t.compare, balance: t.balance}
+}
+
+// Height - Return the height of the tree, zero when empty
+func (t *TreeMap[K, V]) Height() int {
+	return height(t.root)
+}
+
+/*
+	Internal helpers
+*/
+
+func (t *TreeMap[K, V]) none() (K, V, bool) {
+	var (
+		key   K
+		value V
+	)
+	return key, value, false
+}
+
+func (t *TreeMap[K, V]) find(key K) *node[K, V] {
+	n := t.root
+	for n != nil {
+		c := t.compare(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+// seek - Find the nearest key above (up) or below the given key, optionally accepting an exact match
+func (t *TreeMap[K, V]) seek(key K, up, inclusive bool) (K, V, bool) {
+	var best *node[K, V]
+
+	n := t.root
+	for n != nil {
+		c := t.compare(key, n.key)
+		if c == 0 && inclusive {
+			return n.key, n.value, true
+		}
+		if up {
+			if c < 0 {
+				best = n
+				n = n.left
+			} else {
+				n = n.right
+			}
+		} else {
+			if c > 0 {
+				best = n
+				n = n.right
+			} else {
+				n = n.left
+			}
+		}
+	}
+
+	if best == nil {
+		return t.none()
+	}
+	return best.key, best.value, true
+}
+
+func (t *TreeMap[K, V]) fix(n *node[K, V]) *node[K, V] {
+	switch t.balance {
+	case AVL:
+		return balanceAVL(n)
+	case RedBlack:
+		return balanceRedBlack(n)
+	default:
+		update(n)
+		return n
+	}
+}
+
+func (t *TreeMap[K, V]) put(n *node[K, V], key K, value V, inserted *bool) *node[K, V] {
+	if n == nil {
+		*inserted = true
+		return newNode(key, value)
+	}
+
+	c := t.compare(key, n.key)
+	switch {
+	case c < 0:
+		n.left = t.put(n.left, key, value, inserted)
+	case c > 0:
+		n.right = t.put(n.right, key, value, inserted)
+	default:
+		n.value = value
+		return n
+	}
+	return t.fix(n)
+}
+
+func (t *TreeMap[K, V]) deleteMin(n *node[K, V]) *node[K, V] {
+	if n.left == nil {
+		return n.right
+	}
+	n.left = t.deleteMin(n.left)
+	return t.fix(n)
+}
+
+// delete - Remove a key from a plain or AVL subtree
+func (t *TreeMap[K, V]) delete(n *node[K, V], key K) *node[K, V] {
+	if n == nil {
+		return nil
+	}
+
+	c := t.compare(key, n.key)
+	switch {
+	case c < 0:
+		n.left = t.delete(n.left, key)
+	case c > 0:
+		n.right = t.delete(n.right, key)
+	default:
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+		successor := minNode(n.right)
+		n.key, n.value = successor.key, successor.value
+		n.right = t.deleteMin(n.right)
+	}
+	return t.fix(n)
+}
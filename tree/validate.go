@@ -0,0 +1,70 @@
+package tree
+
+import (
+	"errors"
+
+	"github.com/rojack96/treje/common"
+)
+
+// Validate - Check the ordering, height, size and balancing invariants of the tree.
+// It is meant for tests and debugging, it walks the whole tree.
+func (t *TreeMap[K, V]) Validate() error {
+	if t.balance == RedBlack && isRed(t.root) {
+		return errors.New(common.InvalidColor)
+	}
+
+	count, _, err := t.validate(t.root, nil, nil)
+	if err != nil {
+		return err
+	}
+	if count != t.size {
+		return errors.New(common.InvalidSize)
+	}
+	return nil
+}
+
+// validate - Return the node count and black height of a subtree bounded by lo and hi
+func (t *TreeMap[K, V]) validate(n *node[K, V], lo, hi *K) (int, int, error) {
+	if n == nil {
+		return 0, 1, nil
+	}
+
+	if (lo != nil && t.compare(n.key, *lo) <= 0) || (hi != nil && t.compare(n.key, *hi) >= 0) {
+		return 0, 0, errors.New(common.NotOrdered)
+	}
+
+	leftCount, leftBlack, err := t.validate(n.left, lo, &n.key)
+	if err != nil {
+		return 0, 0, err
+	}
+	rightCount, rightBlack, err := t.validate(n.right, &n.key, hi)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	l, r := height(n.left), height(n.right)
+	expected := r + 1
+	if l > r {
+		expected = l + 1
+	}
+	if n.height != expected {
+		return 0, 0, errors.New(common.InvalidHeight)
+	}
+
+	switch t.balance {
+	case AVL:
+		if l-r > 1 || r-l > 1 {
+			return 0, 0, errors.New(common.Unbalanced)
+		}
+	case RedBlack:
+		if isRed(n.right) || (isRed(n) && isRed(n.left)) || leftBlack != rightBlack {
+			return 0, 0, errors.New(common.InvalidColor)
+		}
+	}
+
+	black := leftBlack
+	if !isRed(n) {
+		black++
+	}
+	return leftCount + rightCount + 1, black, nil
+}
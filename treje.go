@@ -1,10 +1,13 @@
 package treje
 
 import (
+	"github.com/rojack96/treje/btree"
+	"github.com/rojack96/treje/common"
 	"github.com/rojack96/treje/mapset"
 	mtype "github.com/rojack96/treje/mapset/types"
 	"github.com/rojack96/treje/set"
 	stypes "github.com/rojack96/treje/set/types"
+	"github.com/rojack96/treje/tree"
 )
 
 func NewSet() stypes.Set {
@@ -14,3 +17,15 @@ func NewSet() stypes.Set {
 func NewMapSet() mtype.MapSet {
 	return mapset.New()
 }
+
+func NewTreeMap[K common.Ordered, V any](balance tree.Balance) *tree.TreeMap[K, V] {
+	return tree.NewMap[K, V](balance)
+}
+
+func NewTreeSet[K common.Ordered](balance tree.Balance, elems ...K) *tree.TreeSet[K] {
+	return tree.NewSet(balance, elems...)
+}
+
+func NewBTree[K common.Ordered, V any](degree int) *btree.BTree[K, V] {
+	return btree.New[K, V](degree)
+}
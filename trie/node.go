@@ -0,0 +1,134 @@
+package trie
+
+import (
+	"sort"
+	"strings"
+)
+
+// node - Radix tree node, prefix is the label of the edge coming from the parent
+type node struct {
+	prefix   string
+	terminal bool
+	children []*node
+}
+
+// child - Return the index of the child whose label starts with b and true if it exists
+func (n *node) child(b byte) (int, bool) {
+	i := sort.Search(len(n.children), func(i int) bool {
+		return n.children[i].prefix[0] >= b
+	})
+	return i, i < len(n.children) && n.children[i].prefix[0] == b
+}
+
+func (n *node) insert(s string) bool {
+	if s == "" {
+		if n.terminal {
+			return false
+		}
+		n.terminal = true
+		return true
+	}
+
+	i, found := n.child(s[0])
+	if !found {
+		n.children = append(n.children, nil)
+		copy(n.children[i+1:], n.children[i:])
+		n.children[i] = &node{prefix: s, terminal: true}
+		return true
+	}
+
+	c := n.children[i]
+	l := commonPrefix(c.prefix, s)
+	if l < len(c.prefix) {
+		split := &node{prefix: c.prefix[:l], children: []*node{c}}
+		c.prefix = c.prefix[l:]
+		n.children[i] = split
+		c = split
+	}
+	return c.insert(s[l:])
+}
+
+func (n *node) delete(s string) bool {
+	if s == "" {
+		if !n.terminal {
+			return false
+		}
+		n.terminal = false
+		return true
+	}
+
+	i, found := n.child(s[0])
+	if !found {
+		return false
+	}
+	c := n.children[i]
+	if !strings.HasPrefix(s, c.prefix) || !c.delete(s[len(c.prefix):]) {
+		return false
+	}
+
+	// Keep the tree compressed: drop empty leaves and merge single-child chains
+	switch {
+	case !c.terminal && len(c.children) == 0:
+		n.children = append(n.children[:i], n.children[i+1:]...)
+	case !c.terminal && len(c.children) == 1:
+		only := c.children[0]
+		only.prefix = c.prefix + only.prefix
+		n.children[i] = only
+	}
+	return true
+}
+
+// find - Return the node holding every key starting with p, and the full path to that node
+func (n *node) find(p string) (*node, string) {
+	path := ""
+	for p != "" {
+		i, found := n.child(p[0])
+		if !found {
+			return nil, ""
+		}
+		c := n.children[i]
+		switch {
+		case strings.HasPrefix(p, c.prefix):
+			path += c.prefix
+			p = p[len(c.prefix):]
+			n = c
+		case strings.HasPrefix(c.prefix, p):
+			return c, path + c.prefix
+		default:
+			return nil, ""
+		}
+	}
+	return n, path
+}
+
+// walk - Visit the keys of the subtree in lexicographic order, path is the key of n
+func (n *node) walk(path string, fn func(string) bool) bool {
+	if n.terminal && !fn(path) {
+		return false
+	}
+	for _, c := range n.children {
+		if !c.walk(path+c.prefix, fn) {
+			return false
+		}
+	}
+	return true
+}
+
+func (n *node) clone() *node {
+	c := &node{prefix: n.prefix, terminal: n.terminal}
+	if len(n.children) > 0 {
+		c.children = make([]*node, len(n.children))
+		for i, child := range n.children {
+			c.children[i] = child.clone()
+		}
+	}
+	return c
+}
+
+func commonPrefix(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
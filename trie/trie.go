@@ -0,0 +1,315 @@
+package trie
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/rojack96/treje/common"
+	mtype "github.com/rojack96/treje/mapset/types"
+	stype "github.com/rojack96/treje/set/types"
+)
+
+// TrieSet - String set backed by a compressed radix tree, elements are kept in lexicographic order
+type TrieSet struct {
+	root *node
+	size int
+}
+
+// New - Create a new empty set or from a slice, duplicates are ignored
+func New(elems ...string) *TrieSet {
+	set := &TrieSet{root: &node{}}
+	for _, e := range elems {
+		if set.root.insert(e) {
+			set.size++
+		}
+	}
+	return set
+}
+
+// FromSet - Create a new set from a slice-backed StringSet
+func FromSet(s stype.StringSet) *TrieSet {
+	set := New()
+	for _, e := range s {
+		if set.root.insert(string(e)) {
+			set.size++
+		}
+	}
+	return set
+}
+
+// FromMapSet - Create a new set from a map-backed StringSet
+func FromMapSet(s mtype.StringSet) *TrieSet {
+	set := New()
+	for e := range s {
+		if set.root.insert(e) {
+			set.size++
+		}
+	}
+	return set
+}
+
+/*
+	Manipulation set methods
+*/
+
+// Add - Append a new element to the set if and only if it is not already present
+func (set *TrieSet) Add(elem string) error {
+	if !set.root.insert(elem) {
+		return errors.New(elem + " " + common.AlreadyExists)
+	}
+	set.size++
+	return nil
+}
+
+// Remove - Remove a specific element from a set, if the element not exists raise an error
+func (set *TrieSet) Remove(elem string) error {
+	if set.IsEmpty() {
+		return errors.New(common.EmptySet)
+	}
+	if !set.root.delete(elem) {
+		return errors.New(common.ElemNotExist)
+	}
+	set.size--
+	return nil
+}
+
+// Discard - Remove a specific element from set
+func (set *TrieSet) Discard(elem string) {
+	if set.root.delete(elem) {
+		set.size--
+	}
+}
+
+/*
+	Set operation methods
+*/
+
+// Union - Returns a new set with the elements of both sets
+func (set *TrieSet) Union(b *TrieSet) *TrieSet {
+	result := &TrieSet{root: set.root.clone(), size: set.size}
+	b.Each(func(elem string) bool {
+		if result.root.insert(elem) {
+			result.size++
+		}
+		return true
+	})
+	return result
+}
+
+// Intersect - Returns the elements that are present in both input sets.
+func (set *TrieSet) Intersect(b *TrieSet) *TrieSet {
+	return set.filter(func(elem string) bool {
+		return b.Has(elem)
+	})
+}
+
+// Difference - Returns the elements that are present in the first set
+// but not in the second set.
+func (set *TrieSet) Difference(b *TrieSet) *TrieSet {
+	return set.filter(func(elem string) bool {
+		return !b.Has(elem)
+	})
+}
+
+// SymmetricDifference - Returns a new set with elements that are present in either of the two sets but not in both.
+func (set *TrieSet) SymmetricDifference(b *TrieSet) *TrieSet {
+	result := set.Difference(b)
+	b.Each(func(elem string) bool {
+		if !set.Has(elem) && result.root.insert(elem) {
+			result.size++
+		}
+		return true
+	})
+	return result
+}
+
+// IsSubsetOf - Returns true if the current set is a subset of the given set b.
+func (set *TrieSet) IsSubsetOf(b *TrieSet) bool {
+	if set.size > b.size {
+		return false
+	}
+	subset := true
+	set.Each(func(elem string) bool {
+		subset = b.Has(elem)
+		return subset
+	})
+	return subset
+}
+
+// Equals - Returns true if the current set and set b contain the same elements.
+func (set *TrieSet) Equals(b *TrieSet) bool {
+	return set.size == b.size && set.IsSubsetOf(b)
+}
+
+/*
+	Prefix methods
+*/
+
+// HasPrefix - Return true if at least one element starts with prefix
+func (set *TrieSet) HasPrefix(prefix string) bool {
+	n, _ := set.root.find(prefix)
+	return n != nil && (n.terminal || len(n.children) > 0)
+}
+
+// WithPrefix - Visit the elements starting with prefix in lexicographic order until fn returns false
+func (set *TrieSet) WithPrefix(prefix string, fn func(elem string) bool) {
+	if n, path := set.root.find(prefix); n != nil {
+		n.walk(path, fn)
+	}
+}
+
+// LongestPrefixOf - Return the longest element that is a prefix of s and true if there is one
+func (set *TrieSet) LongestPrefixOf(s string) (string, bool) {
+	n, consumed, best := set.root, 0, -1
+	if n.terminal {
+		best = 0
+	}
+
+	for consumed < len(s) {
+		i, found := n.child(s[consumed])
+		if !found || !strings.HasPrefix(s[consumed:], n.children[i].prefix) {
+			break
+		}
+		n = n.children[i]
+		consumed += len(n.prefix)
+		if n.terminal {
+			best = consumed
+		}
+	}
+
+	if best < 0 {
+		return "", false
+	}
+	return s[:best], true
+}
+
+/*
+	Utility methods
+*/
+
+// Has - Return true if the element is in set, otherwise false
+func (set *TrieSet) Has(elem string) bool {
+	n, path := set.root.find(elem)
+	return n != nil && n.terminal && len(path) == len(elem)
+}
+
+// Len - Return the number of elements
+func (set *TrieSet) Len() int {
+	return set.size
+}
+
+// IsEmpty - Return true if the set is empty, else false
+func (set *TrieSet) IsEmpty() bool {
+	return set.size == 0
+}
+
+// Clear - Remove all elements
+func (set *TrieSet) Clear() {
+	set.root = &node{}
+	set.size = 0
+}
+
+// Min - Return the lexicographically smallest element
+func (set *TrieSet) Min() (string, error) {
+	if set.IsEmpty() {
+		return "", errors.New(common.EmptySet)
+	}
+
+	var result string
+	set.Each(func(elem string) bool {
+		result = elem
+		return false
+	})
+	return result, nil
+}
+
+// Max - Return the lexicographically greatest element
+func (set *TrieSet) Max() (string, error) {
+	if set.IsEmpty() {
+		return "", errors.New(common.EmptySet)
+	}
+
+	n, path := set.root, ""
+	for len(n.children) > 0 {
+		n = n.children[len(n.children)-1]
+		path += n.prefix
+	}
+	return path, nil
+}
+
+// Concat - Return a string concat of all elements in lexicographic order with a separator
+func (set *TrieSet) Concat(separator string) string {
+	result, err := set.ToSlice()
+	if err != nil {
+		return ""
+	}
+
+	return strings.Join(result, separator)
+}
+
+// Each - Visit the elements in lexicographic order until fn returns false
+func (set *TrieSet) Each(fn func(elem string) bool) {
+	set.root.walk("", fn)
+}
+
+/*
+	Methods to manipulate a set object
+*/
+
+// Copy - Return a deep copy of the set
+func (set *TrieSet) Copy() (*TrieSet, error) {
+	if set.IsEmpty() {
+		return nil, errors.New(common.CopyEmpty)
+	}
+	return &TrieSet{root: set.root.clone(), size: set.size}, nil
+}
+
+// ToSlice - Returns the elements in lexicographic order
+func (set *TrieSet) ToSlice() ([]string, error) {
+	if set.IsEmpty() {
+		return nil, errors.New(common.EmptySet)
+	}
+
+	result := make([]string, 0, set.size)
+	set.Each(func(elem string) bool {
+		result = append(result, elem)
+		return true
+	})
+	return result, nil
+}
+
+// ToSet - Returns a slice-backed StringSet in lexicographic order
+func (set *TrieSet) ToSet() (stype.StringSet, error) {
+	slice, err := set.ToSlice()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(stype.StringSet, len(slice))
+	for i, e := range slice {
+		result[i] = stype.Str(e)
+	}
+	return result, nil
+}
+
+// ToMapSet - Returns a map-backed StringSet
+func (set *TrieSet) ToMapSet() (mtype.StringSet, error) {
+	slice, err := set.ToSlice()
+	if err != nil {
+		return nil, err
+	}
+
+	result, _ := mtype.MapSet{}.String(slice...)
+	return result, nil
+}
+
+func (set *TrieSet) filter(keep func(elem string) bool) *TrieSet {
+	result := New()
+	set.Each(func(elem string) bool {
+		if keep(elem) && result.root.insert(elem) {
+			result.size++
+		}
+		return true
+	})
+	return result
+}